@@ -0,0 +1,4 @@
+// Package backoff provides wrapper functions for "github.com/cenkalti/backoff/v4".
+package backoff
+
+//go:generate go run ./internal/cmd/genretry -mod v1
@@ -0,0 +1,283 @@
+// Command genretry generates the arity variants of the Retry* helpers and their tests.
+//
+//	go run ./internal/cmd/genretry -mod v1
+//	go run ./internal/cmd/genretry -mod v2 -dir v2
+//	go run ./internal/cmd/genretry -mod v3 -dir v3
+//
+// The variants cover fn taking up to MaxParams parameters, and returning up to MaxResults values including error.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// Limits of the arity.
+const (
+	MaxParams  = 4
+	MaxResults = 8
+)
+
+// Func is a generated function.
+type Func struct {
+	Name       string
+	Doc        []string
+	TypeParams []string
+	Params     string
+	Results    string
+	Body       string
+}
+
+// Test is a generated subtest.
+type Test struct {
+	Name string
+	Body string
+}
+
+// File is the data of a template.
+type File struct {
+	Imports []string
+	Funcs   []Func
+	Tests   []Test
+}
+
+var funcs = template.FuncMap{
+	"join": strings.Join,
+}
+
+var sourceTemplate = template.Must(template.New("source").Funcs(funcs).Parse(`// Code generated by genretry. DO NOT EDIT.
+
+package backoff
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+{{range .Funcs}}
+{{range .Doc}}//{{if .}} {{.}}{{end}}
+{{end -}}
+func {{.Name}}{{with .TypeParams}}[{{join . ", "}} any]{{end}}({{.Params}}) {{.Results}} {
+{{.Body}}
+}
+{{end}}`))
+
+var testTemplate = template.Must(template.New("test").Funcs(funcs).Parse(`// Code generated by genretry. DO NOT EDIT.
+
+package backoff_test
+
+import (
+{{- range .Imports}}
+	{{.}}
+{{- end}}
+)
+
+type genCtxKey struct{}
+
+var errGen = errors.New("generated")
+
+func TestGenerated(t *testing.T) {
+	ctx := context.WithValue(context.Background(), genCtxKey{}, "value")
+	_ = ctx
+{{range .Tests}}
+	t.Run("{{.Name}}", func(t *testing.T) {
+{{.Body}}
+	})
+{{end}}
+}
+`))
+
+func main() {
+	mod := flag.String("mod", "", "module to generate: v1, v2 or v3")
+	dir := flag.String("dir", ".", "directory to write the files")
+	flag.Parse()
+
+	var files map[string]File
+	switch *mod {
+	case "v1":
+		files = v1Files()
+	case "v2":
+		files = v2Files("github.com/takumakei/go-backoff/v2")
+	case "v3":
+		files = v2Files("github.com/takumakei/go-backoff/v3")
+	default:
+		log.Fatalf("genretry: unknown -mod %q", *mod)
+	}
+
+	for name, f := range files {
+		tmpl := sourceTemplate
+		if strings.HasSuffix(name, "_test.go") {
+			tmpl = testTemplate
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, f); err != nil {
+			log.Fatalf("genretry: %s: %v", name, err)
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			log.Fatalf("genretry: %s: %v", name, err)
+		}
+		if err := os.WriteFile(filepath.Join(*dir, name), src, 0o644); err != nil {
+			log.Fatalf("genretry: %v", err)
+		}
+	}
+}
+
+// arity describes the parameters and the results of fn.
+// base is the first index of the names, 0 for v1 and 1 for v2.
+type arity struct {
+	p, r, base int
+}
+
+func (a arity) names(prefix string, n int) []string {
+	s := make([]string, n)
+	for i := range s {
+		s[i] = fmt.Sprintf("%s%d", prefix, i+a.base)
+	}
+	return s
+}
+
+// ptypes returns the type parameters of the parameters, e.g. P0, P1.
+func (a arity) ptypes() []string { return a.names("P", a.p) }
+
+// rtypes returns the type parameters of the results except error, e.g. R0, R1.
+func (a arity) rtypes() []string { return a.names("R", a.r-1) }
+
+// pnames returns the names of the parameters, e.g. p0, p1.
+func (a arity) pnames() []string { return a.names("p", a.p) }
+
+// rnames returns the names of the results except error, e.g. r0, r1.
+func (a arity) rnames() []string { return a.names("r", a.r-1) }
+
+// typeParams returns all the type parameters.
+func (a arity) typeParams() []string { return append(a.ptypes(), a.rtypes()...) }
+
+// fnType returns the type of fn, with context.Context as the first parameter if ctx.
+func (a arity) fnType(ctx bool) string {
+	ps := a.ptypes()
+	if ctx {
+		ps = append([]string{"context.Context"}, ps...)
+	}
+	return "func(" + strings.Join(ps, ", ") + ") " + a.results()
+}
+
+// results returns the types of the results.
+func (a arity) results() string { return tuple(append(a.rtypes(), "error")) }
+
+// namedResults returns the named results.
+func (a arity) namedResults() string {
+	var rs []string
+	for i, t := range a.rtypes() {
+		rs = append(rs, a.rnames()[i]+" "+t)
+	}
+	return "(" + strings.Join(append(rs, "err error"), ", ") + ")"
+}
+
+// boundParams returns the parameters bound to fn, with the trailing comma.
+func (a arity) boundParams() string {
+	var s string
+	for i, t := range a.ptypes() {
+		s += a.pnames()[i] + " " + t + ", "
+	}
+	return s
+}
+
+// assign returns the left hand side receiving the results of fn.
+func (a arity) assign() string { return strings.Join(append(a.rnames(), "err"), ", ") }
+
+func tuple(s []string) string {
+	if len(s) == 1 {
+		return s[0]
+	}
+	return "(" + strings.Join(s, ", ") + ")"
+}
+
+// count returns n in words as used in the doc comments.
+func count(n int, one, many string) string {
+	if n == 1 {
+		return "one " + one
+	}
+	return fmt.Sprintf("%d %s", n, many)
+}
+
+// Values used by the generated tests.
+var testValues = []struct{ Type, Value string }{
+	{"string", `"hello"`},
+	{"int", "42"},
+	{"bool", "true"},
+	{"float64", "3.14"},
+	{"complex128", "3 + 4i"},
+	{"rune", "'x'"},
+	{"uint8", "uint8(7)"},
+	{"int64", "int64(-1)"},
+}
+
+// testArity describes fn in the generated tests.
+type testArity struct {
+	arity
+	ctx bool
+}
+
+// fnLit returns the function literal passed as fn in the tests.
+// check is the format of the statement to assert got == want.
+func (a testArity) fnLit(check string) string {
+	var ps, rs, rv []string
+	if a.ctx {
+		ps = append(ps, "ctx context.Context")
+	}
+	for i, name := range a.names("a", a.p) {
+		ps = append(ps, name+" "+testValues[i].Type)
+	}
+	for _, v := range testValues[:a.r-1] {
+		rs = append(rs, v.Type)
+		rv = append(rv, v.Value)
+	}
+	rs = append(rs, "error")
+	rv = append(rv, "errGen")
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "func(%s) %s {\n", strings.Join(ps, ", "), tuple(rs))
+	b.WriteString("n++\n")
+	if a.ctx {
+		fmt.Fprintf(&b, check+"\n", "ctx.Value(genCtxKey{})", `"value"`)
+	}
+	for i, name := range a.names("a", a.p) {
+		fmt.Fprintf(&b, check+"\n", name, testValues[i].Value)
+	}
+	fmt.Fprintf(&b, "return %s\n", strings.Join(rv, ", "))
+	b.WriteString("}")
+	return b.String()
+}
+
+// args returns the line of the values bound to fn in the tests, or empty if no value is bound.
+func (a testArity) args() string {
+	var s string
+	for _, v := range testValues[:a.p] {
+		s += v.Value + ", "
+	}
+	if s != "" {
+		s += "\n"
+	}
+	return s
+}
+
+// lhs returns the variables receiving the results in the tests.
+func (a testArity) lhs() string {
+	return strings.Join(append(a.names("r", a.r-1), "err"), ", ")
+}
+
+// checkResults returns the statements asserting the results in the tests.
+func (a testArity) checkResults(check string) string {
+	var b strings.Builder
+	for i, name := range a.names("r", a.r-1) {
+		fmt.Fprintf(&b, check+"\n", name, testValues[i].Value)
+	}
+	return b.String()
+}
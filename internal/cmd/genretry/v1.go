@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// v1Check asserts got == want in the tests of v1.
+const v1Check = `if %[1]s != %[2]s { t.Errorf("%[1]s = %%v, want %%v", %[1]s, %[2]s) }`
+
+func v1Files() map[string]File {
+	retry := File{Imports: []string{`"github.com/cenkalti/backoff/v4"`, `"github.com/takumakei/go-bind"`}}
+	retryContext := File{Imports: []string{`"context"`, "", `"github.com/cenkalti/backoff/v4"`}}
+	test := File{Imports: []string{`"context"`, `"errors"`, `"testing"`, `"time"`, "", `backoff "github.com/takumakei/go-backoff"`}}
+
+	for p := 0; p <= MaxParams; p++ {
+		for r := 1; r <= MaxResults; r++ {
+			a := arity{p: p, r: r, base: 0}
+			retry.Funcs = append(retry.Funcs, v1Retry(a))
+			retryContext.Funcs = append(retryContext.Funcs, v1RetryContext(a))
+		}
+	}
+	for _, ctx := range []bool{false, true} {
+		for p := 0; p <= MaxParams; p++ {
+			for r := 1; r <= MaxResults; r++ {
+				test.Tests = append(test.Tests, v1Test(testArity{arity{p: p, r: r, base: 0}, ctx}))
+			}
+		}
+	}
+
+	return map[string]File{
+		"retry_gen.go":         retry,
+		"retry_context_gen.go": retryContext,
+		"retry_gen_test.go":    test,
+	}
+}
+
+func v1Name(ctx bool, a arity) string {
+	if ctx {
+		return fmt.Sprintf("RetryContextP%dR%d", a.p, a.r)
+	}
+	return fmt.Sprintf("RetryP%dR%d", a.p, a.r)
+}
+
+func v1Retry(a arity) Func {
+	f := Func{
+		Name:       v1Name(false, a),
+		TypeParams: a.typeParams(),
+		Params:     "fn " + a.fnType(false) + ", " + a.boundParams() + "options ...Option",
+		Results:    a.results(),
+	}
+
+	params := "zero parameter"
+	if a.p > 0 {
+		params = count(a.p, "parameter", "parameters")
+	}
+	results := count(a.r, "value", "values")
+	if a.p == 0 && a.r == 1 {
+		results = "one value of error"
+	}
+	f.Doc = []string{
+		f.Name + " calls fn under backoff.Retry with ExponentialBackOff with options.",
+		"",
+		fmt.Sprintf(" - fn has %s. (P%d)", params, a.p),
+		fmt.Sprintf(" - fn returns %s. (R%d)", results, a.r),
+	}
+
+	switch {
+	case a.p == 0 && a.r == 1:
+		f.Body = "return backoff.Retry(fn, New(options...))"
+	case a.p == 0:
+		f.Results = a.namedResults()
+		f.Body = fmt.Sprintf(`err = backoff.Retry(
+	func() (err error) {
+		%s = fn()
+		return
+	},
+	New(options...),
+)
+return`, a.assign())
+	default:
+		f.Body = fmt.Sprintf("return RetryP0R%d(bind.P%dR%d(fn, %s), options...)",
+			a.r, a.p, a.r, strings.Join(a.pnames(), ", "))
+	}
+	return f
+}
+
+func v1RetryContext(a arity) Func {
+	f := Func{
+		Name:       v1Name(true, a),
+		TypeParams: a.typeParams(),
+		Params:     "ctx context.Context, fn " + a.fnType(true) + ", " + a.boundParams() + "options ...Option",
+		Results:    a.results(),
+	}
+
+	params := "ctx only"
+	if a.p > 0 {
+		params = "ctx and " + count(a.p, "parameter", "parameters")
+	}
+	f.Doc = []string{
+		f.Name + " calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.",
+		"",
+		fmt.Sprintf(" - fn has %s. (P%d)", params, a.p),
+		fmt.Sprintf(" - fn returns %s. (R%d)", count(a.r, "value", "values"), a.r),
+	}
+
+	switch {
+	case a.p == 0 && a.r == 1:
+		f.Doc = append(f.Doc, "",
+			"ctx is applied by backoff.WithContext in addition to the Context option,",
+			"so that retrying stops when either of them is done.",
+			"Only ctx interrupts the wait for the next attempt, and fn is called with ctx.")
+		f.Body = "return backoff.Retry(func() error { return fn(ctx) }, withContext(New(options...), ctx))"
+	case a.p == 0:
+		f.Results = a.namedResults()
+		f.Body = fmt.Sprintf(`err = RetryContextP0R1(
+	ctx,
+	func(ctx context.Context) (err error) {
+		%s = fn(ctx)
+		return
+	},
+	options...,
+)
+return`, a.assign())
+	default:
+		f.Body = fmt.Sprintf("return RetryContextP0R%d(ctx, func(ctx context.Context) %s { return fn(ctx, %s) }, options...)",
+			a.r, a.results(), strings.Join(a.pnames(), ", "))
+	}
+	return f
+}
+
+func v1Test(a testArity) Test {
+	name := v1Name(a.ctx, a.arity)
+	call := "backoff." + name + "(\n"
+	if a.ctx {
+		call += "ctx,\n"
+	}
+	call += a.fnLit(v1Check) + ",\n" + a.args() + "options...,\n)"
+
+	var b strings.Builder
+	b.WriteString("options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}\n")
+	b.WriteString("n := 0\n")
+	fmt.Fprintf(&b, "%s := %s\n", a.lhs(), call)
+	b.WriteString(`if !errors.Is(err, errGen) { t.Errorf("err = %v, want %v", err, errGen) }` + "\n")
+	b.WriteString(a.checkResults(v1Check))
+	b.WriteString("// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.\n")
+	b.WriteString(`if n != 2 { t.Errorf("n = %d, want 2", n) }`)
+	return Test{Name: name, Body: b.String()}
+}
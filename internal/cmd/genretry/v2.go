@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// v2Check asserts got == want in the tests of v2.
+const v2Check = `assert.Equal(t, %[2]s, %[1]s)`
+
+// v2Files returns the files of v2, and of v3 which shares the API of v2.
+func v2Files(module string) map[string]File {
+	retry := File{Imports: []string{`"context"`, "", `"github.com/takumakei/go-bind"`}}
+	test := File{Imports: []string{`"context"`, `"errors"`, `"testing"`, "", `"github.com/stretchr/testify/assert"`, `"` + module + `"`}}
+
+	for p := 0; p <= MaxParams; p++ {
+		for r := 1; r <= MaxResults; r++ {
+			a := arity{p: p, r: r, base: 1}
+			retry.Funcs = append(retry.Funcs, v2Retry(a, false), v2Retry(a, true))
+			test.Tests = append(test.Tests, v2Test(testArity{a, false}), v2Test(testArity{a, true}))
+		}
+	}
+
+	return map[string]File{
+		"retry_gen.go":      retry,
+		"retry_gen_test.go": test,
+	}
+}
+
+func v2Name(ctx bool, a arity) string {
+	name := "Retry"
+	if ctx {
+		name += "Context"
+	}
+	if a.p > 0 {
+		name += fmt.Sprintf("P%d", a.p)
+	}
+	return name + fmt.Sprintf("R%d", a.r)
+}
+
+func v2Retry(a arity, ctx bool) Func {
+	// fn of RetryContextPnRm takes ctx as v1, while fn of RetryContextRm does not.
+	f := Func{
+		Name:       v2Name(ctx, a),
+		TypeParams: a.typeParams(),
+		Params:     "fn " + a.fnType(ctx && a.p > 0) + ", " + a.boundParams() + "options ...Option",
+		Results:    a.results(),
+	}
+	ctxArg, bg := "", "context.Background()"
+	if ctx {
+		f.Params = "ctx context.Context, " + f.Params
+		ctxArg, bg = "ctx, ", "ctx"
+	}
+
+	switch {
+	case a.p == 0 && a.r == 1:
+		alias := "Retry"
+		if ctx {
+			alias = "RetryContext"
+		}
+		f.Doc = []string{fmt.Sprintf("%s is an alias of [%s].", f.Name, alias)}
+		f.Body = fmt.Sprintf("return %s(%sfn, options...)", alias, ctxArg)
+	case a.p == 0:
+		f.Doc = []string{fmt.Sprintf("%s the function fn that returns %d values until it does not return error or BackOff stops.", f.Name, a.r)}
+		f.Results = a.namedResults()
+		f.Body = fmt.Sprintf(`err = retry(
+	%s,
+	func(context.Context) (err error) {
+		%s = fn()
+		return
+	},
+	options,
+)
+return`, bg, a.assign())
+	case ctx && a.r == 1:
+		f.Doc = []string{v2ContextDoc(f.Name, a)}
+		f.Body = fmt.Sprintf(`return retry(
+	ctx,
+	func(ctx context.Context) error {
+		return fn(ctx, %s)
+	},
+	options,
+)`, strings.Join(a.pnames(), ", "))
+	case ctx:
+		f.Doc = []string{v2ContextDoc(f.Name, a)}
+		f.Results = a.namedResults()
+		f.Body = fmt.Sprintf(`err = retry(
+	ctx,
+	func(ctx context.Context) (err error) {
+		%s = fn(ctx, %s)
+		return
+	},
+	options,
+)
+return`, a.assign(), strings.Join(a.pnames(), ", "))
+	default:
+		base := v2Name(ctx, arity{p: 0, r: a.r, base: a.base})
+		f.Doc = []string{fmt.Sprintf("%s is [%s] with fn binding %s.", f.Name, base, count(a.p, "parameter", "parameters"))}
+		f.Body = fmt.Sprintf("return %s(%sbind.P%dR%d(fn, %s), options...)",
+			base, ctxArg, a.p, a.r, strings.Join(a.pnames(), ", "))
+	}
+	return f
+}
+
+// v2ContextDoc returns the doc of RetryContextPnRm, whose fn takes ctx.
+func v2ContextDoc(name string, a arity) string {
+	return fmt.Sprintf("%s is [%s] with fn taking the context of each attempt and binding %s.",
+		name, v2Name(true, arity{p: 0, r: a.r, base: a.base}), count(a.p, "parameter", "parameters"))
+}
+
+func v2Test(a testArity) Test {
+	// fn of v2 takes ctx only if it binds parameters.
+	fa := a
+	fa.ctx = a.ctx && a.p > 0
+
+	name := v2Name(a.ctx, a.arity)
+	call := "backoff." + name + "(\n"
+	if a.ctx {
+		call += "ctx,\n"
+	}
+	call += fa.fnLit(v2Check) + ",\n" + a.args() + "options...,\n)"
+
+	var b strings.Builder
+	b.WriteString("options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}\n")
+	b.WriteString("n := 0\n")
+	fmt.Fprintf(&b, "%s := %s\n", fa.lhs(), call)
+	b.WriteString("assert.ErrorIs(t, err, errGen)\n")
+	b.WriteString(fa.checkResults(v2Check))
+	b.WriteString("// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.\n")
+	b.WriteString("assert.Equal(t, 2, n)")
+	return Test{Name: name, Body: b.String()}
+}
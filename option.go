@@ -83,6 +83,15 @@ func Context(ctx context.Context) Option {
 	return func(bu *builder) { bu.ctx = ctx }
 }
 
+// withContext applies backoff.WithContext with ctx to b, keeping the context applied to b by the Context option.
+// backoff.WithContext replaces the context of b, so b is wrapped to hide it.
+func withContext(b backoff.BackOff, ctx context.Context) backoff.BackOff {
+	if _, ok := b.(backoff.BackOffContext); ok {
+		b = struct{ backoff.BackOff }{b}
+	}
+	return backoff.WithContext(b, ctx)
+}
+
 // MaxRetries applies backoff.WithMaxRetries with max.
 //
 // see: https://pkg.go.dev/github.com/cenkalti/backoff/v4#WithMaxRetries
@@ -0,0 +1,389 @@
+// Code generated by genretry. DO NOT EDIT.
+
+package backoff
+
+import (
+	"context"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+// RetryContextP0R1 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx only. (P0)
+//   - fn returns one value. (R1)
+//
+// ctx is applied by backoff.WithContext in addition to the Context option,
+// so that retrying stops when either of them is done.
+// Only ctx interrupts the wait for the next attempt, and fn is called with ctx.
+func RetryContextP0R1(ctx context.Context, fn func(context.Context) error, options ...Option) error {
+	return backoff.Retry(func() error { return fn(ctx) }, withContext(New(options...), ctx))
+}
+
+// RetryContextP0R2 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx only. (P0)
+//   - fn returns 2 values. (R2)
+func RetryContextP0R2[R0 any](ctx context.Context, fn func(context.Context) (R0, error), options ...Option) (r0 R0, err error) {
+	err = RetryContextP0R1(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r0, err = fn(ctx)
+			return
+		},
+		options...,
+	)
+	return
+}
+
+// RetryContextP0R3 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx only. (P0)
+//   - fn returns 3 values. (R3)
+func RetryContextP0R3[R0, R1 any](ctx context.Context, fn func(context.Context) (R0, R1, error), options ...Option) (r0 R0, r1 R1, err error) {
+	err = RetryContextP0R1(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r0, r1, err = fn(ctx)
+			return
+		},
+		options...,
+	)
+	return
+}
+
+// RetryContextP0R4 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx only. (P0)
+//   - fn returns 4 values. (R4)
+func RetryContextP0R4[R0, R1, R2 any](ctx context.Context, fn func(context.Context) (R0, R1, R2, error), options ...Option) (r0 R0, r1 R1, r2 R2, err error) {
+	err = RetryContextP0R1(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r0, r1, r2, err = fn(ctx)
+			return
+		},
+		options...,
+	)
+	return
+}
+
+// RetryContextP0R5 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx only. (P0)
+//   - fn returns 5 values. (R5)
+func RetryContextP0R5[R0, R1, R2, R3 any](ctx context.Context, fn func(context.Context) (R0, R1, R2, R3, error), options ...Option) (r0 R0, r1 R1, r2 R2, r3 R3, err error) {
+	err = RetryContextP0R1(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r0, r1, r2, r3, err = fn(ctx)
+			return
+		},
+		options...,
+	)
+	return
+}
+
+// RetryContextP0R6 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx only. (P0)
+//   - fn returns 6 values. (R6)
+func RetryContextP0R6[R0, R1, R2, R3, R4 any](ctx context.Context, fn func(context.Context) (R0, R1, R2, R3, R4, error), options ...Option) (r0 R0, r1 R1, r2 R2, r3 R3, r4 R4, err error) {
+	err = RetryContextP0R1(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r0, r1, r2, r3, r4, err = fn(ctx)
+			return
+		},
+		options...,
+	)
+	return
+}
+
+// RetryContextP0R7 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx only. (P0)
+//   - fn returns 7 values. (R7)
+func RetryContextP0R7[R0, R1, R2, R3, R4, R5 any](ctx context.Context, fn func(context.Context) (R0, R1, R2, R3, R4, R5, error), options ...Option) (r0 R0, r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
+	err = RetryContextP0R1(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r0, r1, r2, r3, r4, r5, err = fn(ctx)
+			return
+		},
+		options...,
+	)
+	return
+}
+
+// RetryContextP0R8 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx only. (P0)
+//   - fn returns 8 values. (R8)
+func RetryContextP0R8[R0, R1, R2, R3, R4, R5, R6 any](ctx context.Context, fn func(context.Context) (R0, R1, R2, R3, R4, R5, R6, error), options ...Option) (r0 R0, r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
+	err = RetryContextP0R1(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r0, r1, r2, r3, r4, r5, r6, err = fn(ctx)
+			return
+		},
+		options...,
+	)
+	return
+}
+
+// RetryContextP1R1 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and one parameter. (P1)
+//   - fn returns one value. (R1)
+func RetryContextP1R1[P0 any](ctx context.Context, fn func(context.Context, P0) error, p0 P0, options ...Option) error {
+	return RetryContextP0R1(ctx, func(ctx context.Context) error { return fn(ctx, p0) }, options...)
+}
+
+// RetryContextP1R2 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and one parameter. (P1)
+//   - fn returns 2 values. (R2)
+func RetryContextP1R2[P0, R0 any](ctx context.Context, fn func(context.Context, P0) (R0, error), p0 P0, options ...Option) (R0, error) {
+	return RetryContextP0R2(ctx, func(ctx context.Context) (R0, error) { return fn(ctx, p0) }, options...)
+}
+
+// RetryContextP1R3 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and one parameter. (P1)
+//   - fn returns 3 values. (R3)
+func RetryContextP1R3[P0, R0, R1 any](ctx context.Context, fn func(context.Context, P0) (R0, R1, error), p0 P0, options ...Option) (R0, R1, error) {
+	return RetryContextP0R3(ctx, func(ctx context.Context) (R0, R1, error) { return fn(ctx, p0) }, options...)
+}
+
+// RetryContextP1R4 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and one parameter. (P1)
+//   - fn returns 4 values. (R4)
+func RetryContextP1R4[P0, R0, R1, R2 any](ctx context.Context, fn func(context.Context, P0) (R0, R1, R2, error), p0 P0, options ...Option) (R0, R1, R2, error) {
+	return RetryContextP0R4(ctx, func(ctx context.Context) (R0, R1, R2, error) { return fn(ctx, p0) }, options...)
+}
+
+// RetryContextP1R5 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and one parameter. (P1)
+//   - fn returns 5 values. (R5)
+func RetryContextP1R5[P0, R0, R1, R2, R3 any](ctx context.Context, fn func(context.Context, P0) (R0, R1, R2, R3, error), p0 P0, options ...Option) (R0, R1, R2, R3, error) {
+	return RetryContextP0R5(ctx, func(ctx context.Context) (R0, R1, R2, R3, error) { return fn(ctx, p0) }, options...)
+}
+
+// RetryContextP1R6 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and one parameter. (P1)
+//   - fn returns 6 values. (R6)
+func RetryContextP1R6[P0, R0, R1, R2, R3, R4 any](ctx context.Context, fn func(context.Context, P0) (R0, R1, R2, R3, R4, error), p0 P0, options ...Option) (R0, R1, R2, R3, R4, error) {
+	return RetryContextP0R6(ctx, func(ctx context.Context) (R0, R1, R2, R3, R4, error) { return fn(ctx, p0) }, options...)
+}
+
+// RetryContextP1R7 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and one parameter. (P1)
+//   - fn returns 7 values. (R7)
+func RetryContextP1R7[P0, R0, R1, R2, R3, R4, R5 any](ctx context.Context, fn func(context.Context, P0) (R0, R1, R2, R3, R4, R5, error), p0 P0, options ...Option) (R0, R1, R2, R3, R4, R5, error) {
+	return RetryContextP0R7(ctx, func(ctx context.Context) (R0, R1, R2, R3, R4, R5, error) { return fn(ctx, p0) }, options...)
+}
+
+// RetryContextP1R8 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and one parameter. (P1)
+//   - fn returns 8 values. (R8)
+func RetryContextP1R8[P0, R0, R1, R2, R3, R4, R5, R6 any](ctx context.Context, fn func(context.Context, P0) (R0, R1, R2, R3, R4, R5, R6, error), p0 P0, options ...Option) (R0, R1, R2, R3, R4, R5, R6, error) {
+	return RetryContextP0R8(ctx, func(ctx context.Context) (R0, R1, R2, R3, R4, R5, R6, error) { return fn(ctx, p0) }, options...)
+}
+
+// RetryContextP2R1 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 2 parameters. (P2)
+//   - fn returns one value. (R1)
+func RetryContextP2R1[P0, P1 any](ctx context.Context, fn func(context.Context, P0, P1) error, p0 P0, p1 P1, options ...Option) error {
+	return RetryContextP0R1(ctx, func(ctx context.Context) error { return fn(ctx, p0, p1) }, options...)
+}
+
+// RetryContextP2R2 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 2 parameters. (P2)
+//   - fn returns 2 values. (R2)
+func RetryContextP2R2[P0, P1, R0 any](ctx context.Context, fn func(context.Context, P0, P1) (R0, error), p0 P0, p1 P1, options ...Option) (R0, error) {
+	return RetryContextP0R2(ctx, func(ctx context.Context) (R0, error) { return fn(ctx, p0, p1) }, options...)
+}
+
+// RetryContextP2R3 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 2 parameters. (P2)
+//   - fn returns 3 values. (R3)
+func RetryContextP2R3[P0, P1, R0, R1 any](ctx context.Context, fn func(context.Context, P0, P1) (R0, R1, error), p0 P0, p1 P1, options ...Option) (R0, R1, error) {
+	return RetryContextP0R3(ctx, func(ctx context.Context) (R0, R1, error) { return fn(ctx, p0, p1) }, options...)
+}
+
+// RetryContextP2R4 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 2 parameters. (P2)
+//   - fn returns 4 values. (R4)
+func RetryContextP2R4[P0, P1, R0, R1, R2 any](ctx context.Context, fn func(context.Context, P0, P1) (R0, R1, R2, error), p0 P0, p1 P1, options ...Option) (R0, R1, R2, error) {
+	return RetryContextP0R4(ctx, func(ctx context.Context) (R0, R1, R2, error) { return fn(ctx, p0, p1) }, options...)
+}
+
+// RetryContextP2R5 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 2 parameters. (P2)
+//   - fn returns 5 values. (R5)
+func RetryContextP2R5[P0, P1, R0, R1, R2, R3 any](ctx context.Context, fn func(context.Context, P0, P1) (R0, R1, R2, R3, error), p0 P0, p1 P1, options ...Option) (R0, R1, R2, R3, error) {
+	return RetryContextP0R5(ctx, func(ctx context.Context) (R0, R1, R2, R3, error) { return fn(ctx, p0, p1) }, options...)
+}
+
+// RetryContextP2R6 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 2 parameters. (P2)
+//   - fn returns 6 values. (R6)
+func RetryContextP2R6[P0, P1, R0, R1, R2, R3, R4 any](ctx context.Context, fn func(context.Context, P0, P1) (R0, R1, R2, R3, R4, error), p0 P0, p1 P1, options ...Option) (R0, R1, R2, R3, R4, error) {
+	return RetryContextP0R6(ctx, func(ctx context.Context) (R0, R1, R2, R3, R4, error) { return fn(ctx, p0, p1) }, options...)
+}
+
+// RetryContextP2R7 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 2 parameters. (P2)
+//   - fn returns 7 values. (R7)
+func RetryContextP2R7[P0, P1, R0, R1, R2, R3, R4, R5 any](ctx context.Context, fn func(context.Context, P0, P1) (R0, R1, R2, R3, R4, R5, error), p0 P0, p1 P1, options ...Option) (R0, R1, R2, R3, R4, R5, error) {
+	return RetryContextP0R7(ctx, func(ctx context.Context) (R0, R1, R2, R3, R4, R5, error) { return fn(ctx, p0, p1) }, options...)
+}
+
+// RetryContextP2R8 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 2 parameters. (P2)
+//   - fn returns 8 values. (R8)
+func RetryContextP2R8[P0, P1, R0, R1, R2, R3, R4, R5, R6 any](ctx context.Context, fn func(context.Context, P0, P1) (R0, R1, R2, R3, R4, R5, R6, error), p0 P0, p1 P1, options ...Option) (R0, R1, R2, R3, R4, R5, R6, error) {
+	return RetryContextP0R8(ctx, func(ctx context.Context) (R0, R1, R2, R3, R4, R5, R6, error) { return fn(ctx, p0, p1) }, options...)
+}
+
+// RetryContextP3R1 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 3 parameters. (P3)
+//   - fn returns one value. (R1)
+func RetryContextP3R1[P0, P1, P2 any](ctx context.Context, fn func(context.Context, P0, P1, P2) error, p0 P0, p1 P1, p2 P2, options ...Option) error {
+	return RetryContextP0R1(ctx, func(ctx context.Context) error { return fn(ctx, p0, p1, p2) }, options...)
+}
+
+// RetryContextP3R2 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 3 parameters. (P3)
+//   - fn returns 2 values. (R2)
+func RetryContextP3R2[P0, P1, P2, R0 any](ctx context.Context, fn func(context.Context, P0, P1, P2) (R0, error), p0 P0, p1 P1, p2 P2, options ...Option) (R0, error) {
+	return RetryContextP0R2(ctx, func(ctx context.Context) (R0, error) { return fn(ctx, p0, p1, p2) }, options...)
+}
+
+// RetryContextP3R3 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 3 parameters. (P3)
+//   - fn returns 3 values. (R3)
+func RetryContextP3R3[P0, P1, P2, R0, R1 any](ctx context.Context, fn func(context.Context, P0, P1, P2) (R0, R1, error), p0 P0, p1 P1, p2 P2, options ...Option) (R0, R1, error) {
+	return RetryContextP0R3(ctx, func(ctx context.Context) (R0, R1, error) { return fn(ctx, p0, p1, p2) }, options...)
+}
+
+// RetryContextP3R4 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 3 parameters. (P3)
+//   - fn returns 4 values. (R4)
+func RetryContextP3R4[P0, P1, P2, R0, R1, R2 any](ctx context.Context, fn func(context.Context, P0, P1, P2) (R0, R1, R2, error), p0 P0, p1 P1, p2 P2, options ...Option) (R0, R1, R2, error) {
+	return RetryContextP0R4(ctx, func(ctx context.Context) (R0, R1, R2, error) { return fn(ctx, p0, p1, p2) }, options...)
+}
+
+// RetryContextP3R5 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 3 parameters. (P3)
+//   - fn returns 5 values. (R5)
+func RetryContextP3R5[P0, P1, P2, R0, R1, R2, R3 any](ctx context.Context, fn func(context.Context, P0, P1, P2) (R0, R1, R2, R3, error), p0 P0, p1 P1, p2 P2, options ...Option) (R0, R1, R2, R3, error) {
+	return RetryContextP0R5(ctx, func(ctx context.Context) (R0, R1, R2, R3, error) { return fn(ctx, p0, p1, p2) }, options...)
+}
+
+// RetryContextP3R6 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 3 parameters. (P3)
+//   - fn returns 6 values. (R6)
+func RetryContextP3R6[P0, P1, P2, R0, R1, R2, R3, R4 any](ctx context.Context, fn func(context.Context, P0, P1, P2) (R0, R1, R2, R3, R4, error), p0 P0, p1 P1, p2 P2, options ...Option) (R0, R1, R2, R3, R4, error) {
+	return RetryContextP0R6(ctx, func(ctx context.Context) (R0, R1, R2, R3, R4, error) { return fn(ctx, p0, p1, p2) }, options...)
+}
+
+// RetryContextP3R7 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 3 parameters. (P3)
+//   - fn returns 7 values. (R7)
+func RetryContextP3R7[P0, P1, P2, R0, R1, R2, R3, R4, R5 any](ctx context.Context, fn func(context.Context, P0, P1, P2) (R0, R1, R2, R3, R4, R5, error), p0 P0, p1 P1, p2 P2, options ...Option) (R0, R1, R2, R3, R4, R5, error) {
+	return RetryContextP0R7(ctx, func(ctx context.Context) (R0, R1, R2, R3, R4, R5, error) { return fn(ctx, p0, p1, p2) }, options...)
+}
+
+// RetryContextP3R8 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 3 parameters. (P3)
+//   - fn returns 8 values. (R8)
+func RetryContextP3R8[P0, P1, P2, R0, R1, R2, R3, R4, R5, R6 any](ctx context.Context, fn func(context.Context, P0, P1, P2) (R0, R1, R2, R3, R4, R5, R6, error), p0 P0, p1 P1, p2 P2, options ...Option) (R0, R1, R2, R3, R4, R5, R6, error) {
+	return RetryContextP0R8(ctx, func(ctx context.Context) (R0, R1, R2, R3, R4, R5, R6, error) { return fn(ctx, p0, p1, p2) }, options...)
+}
+
+// RetryContextP4R1 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 4 parameters. (P4)
+//   - fn returns one value. (R1)
+func RetryContextP4R1[P0, P1, P2, P3 any](ctx context.Context, fn func(context.Context, P0, P1, P2, P3) error, p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) error {
+	return RetryContextP0R1(ctx, func(ctx context.Context) error { return fn(ctx, p0, p1, p2, p3) }, options...)
+}
+
+// RetryContextP4R2 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 4 parameters. (P4)
+//   - fn returns 2 values. (R2)
+func RetryContextP4R2[P0, P1, P2, P3, R0 any](ctx context.Context, fn func(context.Context, P0, P1, P2, P3) (R0, error), p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) (R0, error) {
+	return RetryContextP0R2(ctx, func(ctx context.Context) (R0, error) { return fn(ctx, p0, p1, p2, p3) }, options...)
+}
+
+// RetryContextP4R3 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 4 parameters. (P4)
+//   - fn returns 3 values. (R3)
+func RetryContextP4R3[P0, P1, P2, P3, R0, R1 any](ctx context.Context, fn func(context.Context, P0, P1, P2, P3) (R0, R1, error), p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) (R0, R1, error) {
+	return RetryContextP0R3(ctx, func(ctx context.Context) (R0, R1, error) { return fn(ctx, p0, p1, p2, p3) }, options...)
+}
+
+// RetryContextP4R4 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 4 parameters. (P4)
+//   - fn returns 4 values. (R4)
+func RetryContextP4R4[P0, P1, P2, P3, R0, R1, R2 any](ctx context.Context, fn func(context.Context, P0, P1, P2, P3) (R0, R1, R2, error), p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) (R0, R1, R2, error) {
+	return RetryContextP0R4(ctx, func(ctx context.Context) (R0, R1, R2, error) { return fn(ctx, p0, p1, p2, p3) }, options...)
+}
+
+// RetryContextP4R5 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 4 parameters. (P4)
+//   - fn returns 5 values. (R5)
+func RetryContextP4R5[P0, P1, P2, P3, R0, R1, R2, R3 any](ctx context.Context, fn func(context.Context, P0, P1, P2, P3) (R0, R1, R2, R3, error), p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) (R0, R1, R2, R3, error) {
+	return RetryContextP0R5(ctx, func(ctx context.Context) (R0, R1, R2, R3, error) { return fn(ctx, p0, p1, p2, p3) }, options...)
+}
+
+// RetryContextP4R6 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 4 parameters. (P4)
+//   - fn returns 6 values. (R6)
+func RetryContextP4R6[P0, P1, P2, P3, R0, R1, R2, R3, R4 any](ctx context.Context, fn func(context.Context, P0, P1, P2, P3) (R0, R1, R2, R3, R4, error), p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) (R0, R1, R2, R3, R4, error) {
+	return RetryContextP0R6(ctx, func(ctx context.Context) (R0, R1, R2, R3, R4, error) { return fn(ctx, p0, p1, p2, p3) }, options...)
+}
+
+// RetryContextP4R7 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 4 parameters. (P4)
+//   - fn returns 7 values. (R7)
+func RetryContextP4R7[P0, P1, P2, P3, R0, R1, R2, R3, R4, R5 any](ctx context.Context, fn func(context.Context, P0, P1, P2, P3) (R0, R1, R2, R3, R4, R5, error), p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) (R0, R1, R2, R3, R4, R5, error) {
+	return RetryContextP0R7(ctx, func(ctx context.Context) (R0, R1, R2, R3, R4, R5, error) { return fn(ctx, p0, p1, p2, p3) }, options...)
+}
+
+// RetryContextP4R8 calls fn with ctx under backoff.Retry with ExponentialBackOff with options and ctx.
+//
+//   - fn has ctx and 4 parameters. (P4)
+//   - fn returns 8 values. (R8)
+func RetryContextP4R8[P0, P1, P2, P3, R0, R1, R2, R3, R4, R5, R6 any](ctx context.Context, fn func(context.Context, P0, P1, P2, P3) (R0, R1, R2, R3, R4, R5, R6, error), p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) (R0, R1, R2, R3, R4, R5, R6, error) {
+	return RetryContextP0R8(ctx, func(ctx context.Context) (R0, R1, R2, R3, R4, R5, R6, error) { return fn(ctx, p0, p1, p2, p3) }, options...)
+}
@@ -0,0 +1,101 @@
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	backoff "github.com/takumakei/go-backoff"
+)
+
+type ctxKey struct{}
+
+func TestRetryContextP2R3(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	n := 0
+	never := errors.New("never")
+	s, i, err := backoff.RetryContextP2R3(
+		ctx,
+		func(ctx context.Context, a string, b int) (string, int, error) {
+			n++
+			return ctx.Value(ctxKey{}).(string) + a, b, never
+		},
+		"-hello",
+		42,
+		backoff.InitialInterval(time.Millisecond),
+		backoff.MaxInterval(time.Millisecond),
+		backoff.MaxRetries(3),
+	)
+	if !errors.Is(err, never) {
+		t.Errorf("err = %v, want %v", err, never)
+	}
+	if s != "value-hello" || i != 42 {
+		t.Errorf("got (%q, %d), want (%q, %d)", s, i, "value-hello", 42)
+	}
+	// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+	if n != 4 {
+		t.Errorf("n = %d, want 4", n)
+	}
+}
+
+func TestRetryContextP0R1(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // キャンセル
+
+	n := 0
+	err := backoff.RetryContextP0R1(
+		ctx,
+		func(context.Context) error {
+			n++
+			return errors.New("never")
+		},
+		backoff.InitialInterval(time.Millisecond),
+		backoff.MaxRetries(3),
+	)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want %v", err, context.Canceled)
+	}
+	// fn は必ず1回実行される
+	if n != 1 {
+		t.Errorf("n = %d, want 1", n)
+	}
+}
+
+func TestRetryContextP0R1Context(t *testing.T) {
+	octx, cancel := context.WithCancel(context.Background())
+	cancel() // Context オプションの ctx だけキャンセル
+
+	n := 0
+	never := errors.New("never")
+	err := backoff.RetryContextP0R1(
+		context.Background(),
+		func(context.Context) error {
+			n++
+			return never
+		},
+		backoff.InitialInterval(time.Millisecond),
+		backoff.MaxRetries(3),
+		backoff.Context(octx),
+	)
+	if !errors.Is(err, never) {
+		t.Errorf("err = %v, want %v", err, never)
+	}
+	// どちらの ctx が終了してもリトライしない
+	if n != 1 {
+		t.Errorf("n = %d, want 1", n)
+	}
+}
+
+func TestRetryP4R5(t *testing.T) {
+	a, b, c, d, err := backoff.RetryP4R5(
+		func(a string, b int, c bool, d float64) (string, int, bool, float64, error) {
+			return a, b, c, d, nil
+		},
+		"hello", 42, true, 3.14,
+	)
+	if err != nil || a != "hello" || b != 42 || !c || d != 3.14 {
+		t.Errorf("got (%q, %d, %v, %v, %v)", a, b, c, d, err)
+	}
+}
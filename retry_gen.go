@@ -0,0 +1,377 @@
+// Code generated by genretry. DO NOT EDIT.
+
+package backoff
+
+import (
+	"github.com/cenkalti/backoff/v4"
+	"github.com/takumakei/go-bind"
+)
+
+// RetryP0R1 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has zero parameter. (P0)
+//   - fn returns one value of error. (R1)
+func RetryP0R1(fn func() error, options ...Option) error {
+	return backoff.Retry(fn, New(options...))
+}
+
+// RetryP0R2 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has zero parameter. (P0)
+//   - fn returns 2 values. (R2)
+func RetryP0R2[R0 any](fn func() (R0, error), options ...Option) (r0 R0, err error) {
+	err = backoff.Retry(
+		func() (err error) {
+			r0, err = fn()
+			return
+		},
+		New(options...),
+	)
+	return
+}
+
+// RetryP0R3 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has zero parameter. (P0)
+//   - fn returns 3 values. (R3)
+func RetryP0R3[R0, R1 any](fn func() (R0, R1, error), options ...Option) (r0 R0, r1 R1, err error) {
+	err = backoff.Retry(
+		func() (err error) {
+			r0, r1, err = fn()
+			return
+		},
+		New(options...),
+	)
+	return
+}
+
+// RetryP0R4 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has zero parameter. (P0)
+//   - fn returns 4 values. (R4)
+func RetryP0R4[R0, R1, R2 any](fn func() (R0, R1, R2, error), options ...Option) (r0 R0, r1 R1, r2 R2, err error) {
+	err = backoff.Retry(
+		func() (err error) {
+			r0, r1, r2, err = fn()
+			return
+		},
+		New(options...),
+	)
+	return
+}
+
+// RetryP0R5 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has zero parameter. (P0)
+//   - fn returns 5 values. (R5)
+func RetryP0R5[R0, R1, R2, R3 any](fn func() (R0, R1, R2, R3, error), options ...Option) (r0 R0, r1 R1, r2 R2, r3 R3, err error) {
+	err = backoff.Retry(
+		func() (err error) {
+			r0, r1, r2, r3, err = fn()
+			return
+		},
+		New(options...),
+	)
+	return
+}
+
+// RetryP0R6 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has zero parameter. (P0)
+//   - fn returns 6 values. (R6)
+func RetryP0R6[R0, R1, R2, R3, R4 any](fn func() (R0, R1, R2, R3, R4, error), options ...Option) (r0 R0, r1 R1, r2 R2, r3 R3, r4 R4, err error) {
+	err = backoff.Retry(
+		func() (err error) {
+			r0, r1, r2, r3, r4, err = fn()
+			return
+		},
+		New(options...),
+	)
+	return
+}
+
+// RetryP0R7 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has zero parameter. (P0)
+//   - fn returns 7 values. (R7)
+func RetryP0R7[R0, R1, R2, R3, R4, R5 any](fn func() (R0, R1, R2, R3, R4, R5, error), options ...Option) (r0 R0, r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
+	err = backoff.Retry(
+		func() (err error) {
+			r0, r1, r2, r3, r4, r5, err = fn()
+			return
+		},
+		New(options...),
+	)
+	return
+}
+
+// RetryP0R8 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has zero parameter. (P0)
+//   - fn returns 8 values. (R8)
+func RetryP0R8[R0, R1, R2, R3, R4, R5, R6 any](fn func() (R0, R1, R2, R3, R4, R5, R6, error), options ...Option) (r0 R0, r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
+	err = backoff.Retry(
+		func() (err error) {
+			r0, r1, r2, r3, r4, r5, r6, err = fn()
+			return
+		},
+		New(options...),
+	)
+	return
+}
+
+// RetryP1R1 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has one parameter. (P1)
+//   - fn returns one value. (R1)
+func RetryP1R1[P0 any](fn func(P0) error, p0 P0, options ...Option) error {
+	return RetryP0R1(bind.P1R1(fn, p0), options...)
+}
+
+// RetryP1R2 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has one parameter. (P1)
+//   - fn returns 2 values. (R2)
+func RetryP1R2[P0, R0 any](fn func(P0) (R0, error), p0 P0, options ...Option) (R0, error) {
+	return RetryP0R2(bind.P1R2(fn, p0), options...)
+}
+
+// RetryP1R3 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has one parameter. (P1)
+//   - fn returns 3 values. (R3)
+func RetryP1R3[P0, R0, R1 any](fn func(P0) (R0, R1, error), p0 P0, options ...Option) (R0, R1, error) {
+	return RetryP0R3(bind.P1R3(fn, p0), options...)
+}
+
+// RetryP1R4 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has one parameter. (P1)
+//   - fn returns 4 values. (R4)
+func RetryP1R4[P0, R0, R1, R2 any](fn func(P0) (R0, R1, R2, error), p0 P0, options ...Option) (R0, R1, R2, error) {
+	return RetryP0R4(bind.P1R4(fn, p0), options...)
+}
+
+// RetryP1R5 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has one parameter. (P1)
+//   - fn returns 5 values. (R5)
+func RetryP1R5[P0, R0, R1, R2, R3 any](fn func(P0) (R0, R1, R2, R3, error), p0 P0, options ...Option) (R0, R1, R2, R3, error) {
+	return RetryP0R5(bind.P1R5(fn, p0), options...)
+}
+
+// RetryP1R6 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has one parameter. (P1)
+//   - fn returns 6 values. (R6)
+func RetryP1R6[P0, R0, R1, R2, R3, R4 any](fn func(P0) (R0, R1, R2, R3, R4, error), p0 P0, options ...Option) (R0, R1, R2, R3, R4, error) {
+	return RetryP0R6(bind.P1R6(fn, p0), options...)
+}
+
+// RetryP1R7 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has one parameter. (P1)
+//   - fn returns 7 values. (R7)
+func RetryP1R7[P0, R0, R1, R2, R3, R4, R5 any](fn func(P0) (R0, R1, R2, R3, R4, R5, error), p0 P0, options ...Option) (R0, R1, R2, R3, R4, R5, error) {
+	return RetryP0R7(bind.P1R7(fn, p0), options...)
+}
+
+// RetryP1R8 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has one parameter. (P1)
+//   - fn returns 8 values. (R8)
+func RetryP1R8[P0, R0, R1, R2, R3, R4, R5, R6 any](fn func(P0) (R0, R1, R2, R3, R4, R5, R6, error), p0 P0, options ...Option) (R0, R1, R2, R3, R4, R5, R6, error) {
+	return RetryP0R8(bind.P1R8(fn, p0), options...)
+}
+
+// RetryP2R1 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 2 parameters. (P2)
+//   - fn returns one value. (R1)
+func RetryP2R1[P0, P1 any](fn func(P0, P1) error, p0 P0, p1 P1, options ...Option) error {
+	return RetryP0R1(bind.P2R1(fn, p0, p1), options...)
+}
+
+// RetryP2R2 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 2 parameters. (P2)
+//   - fn returns 2 values. (R2)
+func RetryP2R2[P0, P1, R0 any](fn func(P0, P1) (R0, error), p0 P0, p1 P1, options ...Option) (R0, error) {
+	return RetryP0R2(bind.P2R2(fn, p0, p1), options...)
+}
+
+// RetryP2R3 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 2 parameters. (P2)
+//   - fn returns 3 values. (R3)
+func RetryP2R3[P0, P1, R0, R1 any](fn func(P0, P1) (R0, R1, error), p0 P0, p1 P1, options ...Option) (R0, R1, error) {
+	return RetryP0R3(bind.P2R3(fn, p0, p1), options...)
+}
+
+// RetryP2R4 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 2 parameters. (P2)
+//   - fn returns 4 values. (R4)
+func RetryP2R4[P0, P1, R0, R1, R2 any](fn func(P0, P1) (R0, R1, R2, error), p0 P0, p1 P1, options ...Option) (R0, R1, R2, error) {
+	return RetryP0R4(bind.P2R4(fn, p0, p1), options...)
+}
+
+// RetryP2R5 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 2 parameters. (P2)
+//   - fn returns 5 values. (R5)
+func RetryP2R5[P0, P1, R0, R1, R2, R3 any](fn func(P0, P1) (R0, R1, R2, R3, error), p0 P0, p1 P1, options ...Option) (R0, R1, R2, R3, error) {
+	return RetryP0R5(bind.P2R5(fn, p0, p1), options...)
+}
+
+// RetryP2R6 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 2 parameters. (P2)
+//   - fn returns 6 values. (R6)
+func RetryP2R6[P0, P1, R0, R1, R2, R3, R4 any](fn func(P0, P1) (R0, R1, R2, R3, R4, error), p0 P0, p1 P1, options ...Option) (R0, R1, R2, R3, R4, error) {
+	return RetryP0R6(bind.P2R6(fn, p0, p1), options...)
+}
+
+// RetryP2R7 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 2 parameters. (P2)
+//   - fn returns 7 values. (R7)
+func RetryP2R7[P0, P1, R0, R1, R2, R3, R4, R5 any](fn func(P0, P1) (R0, R1, R2, R3, R4, R5, error), p0 P0, p1 P1, options ...Option) (R0, R1, R2, R3, R4, R5, error) {
+	return RetryP0R7(bind.P2R7(fn, p0, p1), options...)
+}
+
+// RetryP2R8 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 2 parameters. (P2)
+//   - fn returns 8 values. (R8)
+func RetryP2R8[P0, P1, R0, R1, R2, R3, R4, R5, R6 any](fn func(P0, P1) (R0, R1, R2, R3, R4, R5, R6, error), p0 P0, p1 P1, options ...Option) (R0, R1, R2, R3, R4, R5, R6, error) {
+	return RetryP0R8(bind.P2R8(fn, p0, p1), options...)
+}
+
+// RetryP3R1 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 3 parameters. (P3)
+//   - fn returns one value. (R1)
+func RetryP3R1[P0, P1, P2 any](fn func(P0, P1, P2) error, p0 P0, p1 P1, p2 P2, options ...Option) error {
+	return RetryP0R1(bind.P3R1(fn, p0, p1, p2), options...)
+}
+
+// RetryP3R2 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 3 parameters. (P3)
+//   - fn returns 2 values. (R2)
+func RetryP3R2[P0, P1, P2, R0 any](fn func(P0, P1, P2) (R0, error), p0 P0, p1 P1, p2 P2, options ...Option) (R0, error) {
+	return RetryP0R2(bind.P3R2(fn, p0, p1, p2), options...)
+}
+
+// RetryP3R3 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 3 parameters. (P3)
+//   - fn returns 3 values. (R3)
+func RetryP3R3[P0, P1, P2, R0, R1 any](fn func(P0, P1, P2) (R0, R1, error), p0 P0, p1 P1, p2 P2, options ...Option) (R0, R1, error) {
+	return RetryP0R3(bind.P3R3(fn, p0, p1, p2), options...)
+}
+
+// RetryP3R4 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 3 parameters. (P3)
+//   - fn returns 4 values. (R4)
+func RetryP3R4[P0, P1, P2, R0, R1, R2 any](fn func(P0, P1, P2) (R0, R1, R2, error), p0 P0, p1 P1, p2 P2, options ...Option) (R0, R1, R2, error) {
+	return RetryP0R4(bind.P3R4(fn, p0, p1, p2), options...)
+}
+
+// RetryP3R5 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 3 parameters. (P3)
+//   - fn returns 5 values. (R5)
+func RetryP3R5[P0, P1, P2, R0, R1, R2, R3 any](fn func(P0, P1, P2) (R0, R1, R2, R3, error), p0 P0, p1 P1, p2 P2, options ...Option) (R0, R1, R2, R3, error) {
+	return RetryP0R5(bind.P3R5(fn, p0, p1, p2), options...)
+}
+
+// RetryP3R6 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 3 parameters. (P3)
+//   - fn returns 6 values. (R6)
+func RetryP3R6[P0, P1, P2, R0, R1, R2, R3, R4 any](fn func(P0, P1, P2) (R0, R1, R2, R3, R4, error), p0 P0, p1 P1, p2 P2, options ...Option) (R0, R1, R2, R3, R4, error) {
+	return RetryP0R6(bind.P3R6(fn, p0, p1, p2), options...)
+}
+
+// RetryP3R7 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 3 parameters. (P3)
+//   - fn returns 7 values. (R7)
+func RetryP3R7[P0, P1, P2, R0, R1, R2, R3, R4, R5 any](fn func(P0, P1, P2) (R0, R1, R2, R3, R4, R5, error), p0 P0, p1 P1, p2 P2, options ...Option) (R0, R1, R2, R3, R4, R5, error) {
+	return RetryP0R7(bind.P3R7(fn, p0, p1, p2), options...)
+}
+
+// RetryP3R8 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 3 parameters. (P3)
+//   - fn returns 8 values. (R8)
+func RetryP3R8[P0, P1, P2, R0, R1, R2, R3, R4, R5, R6 any](fn func(P0, P1, P2) (R0, R1, R2, R3, R4, R5, R6, error), p0 P0, p1 P1, p2 P2, options ...Option) (R0, R1, R2, R3, R4, R5, R6, error) {
+	return RetryP0R8(bind.P3R8(fn, p0, p1, p2), options...)
+}
+
+// RetryP4R1 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 4 parameters. (P4)
+//   - fn returns one value. (R1)
+func RetryP4R1[P0, P1, P2, P3 any](fn func(P0, P1, P2, P3) error, p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) error {
+	return RetryP0R1(bind.P4R1(fn, p0, p1, p2, p3), options...)
+}
+
+// RetryP4R2 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 4 parameters. (P4)
+//   - fn returns 2 values. (R2)
+func RetryP4R2[P0, P1, P2, P3, R0 any](fn func(P0, P1, P2, P3) (R0, error), p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) (R0, error) {
+	return RetryP0R2(bind.P4R2(fn, p0, p1, p2, p3), options...)
+}
+
+// RetryP4R3 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 4 parameters. (P4)
+//   - fn returns 3 values. (R3)
+func RetryP4R3[P0, P1, P2, P3, R0, R1 any](fn func(P0, P1, P2, P3) (R0, R1, error), p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) (R0, R1, error) {
+	return RetryP0R3(bind.P4R3(fn, p0, p1, p2, p3), options...)
+}
+
+// RetryP4R4 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 4 parameters. (P4)
+//   - fn returns 4 values. (R4)
+func RetryP4R4[P0, P1, P2, P3, R0, R1, R2 any](fn func(P0, P1, P2, P3) (R0, R1, R2, error), p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) (R0, R1, R2, error) {
+	return RetryP0R4(bind.P4R4(fn, p0, p1, p2, p3), options...)
+}
+
+// RetryP4R5 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 4 parameters. (P4)
+//   - fn returns 5 values. (R5)
+func RetryP4R5[P0, P1, P2, P3, R0, R1, R2, R3 any](fn func(P0, P1, P2, P3) (R0, R1, R2, R3, error), p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) (R0, R1, R2, R3, error) {
+	return RetryP0R5(bind.P4R5(fn, p0, p1, p2, p3), options...)
+}
+
+// RetryP4R6 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 4 parameters. (P4)
+//   - fn returns 6 values. (R6)
+func RetryP4R6[P0, P1, P2, P3, R0, R1, R2, R3, R4 any](fn func(P0, P1, P2, P3) (R0, R1, R2, R3, R4, error), p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) (R0, R1, R2, R3, R4, error) {
+	return RetryP0R6(bind.P4R6(fn, p0, p1, p2, p3), options...)
+}
+
+// RetryP4R7 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 4 parameters. (P4)
+//   - fn returns 7 values. (R7)
+func RetryP4R7[P0, P1, P2, P3, R0, R1, R2, R3, R4, R5 any](fn func(P0, P1, P2, P3) (R0, R1, R2, R3, R4, R5, error), p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) (R0, R1, R2, R3, R4, R5, error) {
+	return RetryP0R7(bind.P4R7(fn, p0, p1, p2, p3), options...)
+}
+
+// RetryP4R8 calls fn under backoff.Retry with ExponentialBackOff with options.
+//
+//   - fn has 4 parameters. (P4)
+//   - fn returns 8 values. (R8)
+func RetryP4R8[P0, P1, P2, P3, R0, R1, R2, R3, R4, R5, R6 any](fn func(P0, P1, P2, P3) (R0, R1, R2, R3, R4, R5, R6, error), p0 P0, p1 P1, p2 P2, p3 P3, options ...Option) (R0, R1, R2, R3, R4, R5, R6, error) {
+	return RetryP0R8(bind.P4R8(fn, p0, p1, p2, p3), options...)
+}
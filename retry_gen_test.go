@@ -0,0 +1,3086 @@
+// Code generated by genretry. DO NOT EDIT.
+
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	backoff "github.com/takumakei/go-backoff"
+)
+
+type genCtxKey struct{}
+
+var errGen = errors.New("generated")
+
+func TestGenerated(t *testing.T) {
+	ctx := context.WithValue(context.Background(), genCtxKey{}, "value")
+	_ = ctx
+
+	t.Run("RetryP0R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryP0R1(
+			func() error {
+				n++
+				return errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP0R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, err := backoff.RetryP0R2(
+			func() (string, error) {
+				n++
+				return "hello", errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP0R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, err := backoff.RetryP0R3(
+			func() (string, int, error) {
+				n++
+				return "hello", 42, errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP0R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, err := backoff.RetryP0R4(
+			func() (string, int, bool, error) {
+				n++
+				return "hello", 42, true, errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP0R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, err := backoff.RetryP0R5(
+			func() (string, int, bool, float64, error) {
+				n++
+				return "hello", 42, true, 3.14, errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP0R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, err := backoff.RetryP0R6(
+			func() (string, int, bool, float64, complex128, error) {
+				n++
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP0R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, err := backoff.RetryP0R7(
+			func() (string, int, bool, float64, complex128, rune, error) {
+				n++
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP0R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, r6, err := backoff.RetryP0R8(
+			func() (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		if r6 != uint8(7) {
+			t.Errorf("r6 = %v, want %v", r6, uint8(7))
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP1R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryP1R1(
+			func(a0 string) error {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP1R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, err := backoff.RetryP1R2(
+			func(a0 string) (string, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return "hello", errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP1R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, err := backoff.RetryP1R3(
+			func(a0 string) (string, int, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return "hello", 42, errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP1R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, err := backoff.RetryP1R4(
+			func(a0 string) (string, int, bool, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return "hello", 42, true, errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP1R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, err := backoff.RetryP1R5(
+			func(a0 string) (string, int, bool, float64, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP1R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, err := backoff.RetryP1R6(
+			func(a0 string) (string, int, bool, float64, complex128, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP1R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, err := backoff.RetryP1R7(
+			func(a0 string) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP1R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, r6, err := backoff.RetryP1R8(
+			func(a0 string) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		if r6 != uint8(7) {
+			t.Errorf("r6 = %v, want %v", r6, uint8(7))
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP2R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryP2R1(
+			func(a0 string, a1 int) error {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP2R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, err := backoff.RetryP2R2(
+			func(a0 string, a1 int) (string, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return "hello", errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP2R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, err := backoff.RetryP2R3(
+			func(a0 string, a1 int) (string, int, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return "hello", 42, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP2R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, err := backoff.RetryP2R4(
+			func(a0 string, a1 int) (string, int, bool, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return "hello", 42, true, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP2R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, err := backoff.RetryP2R5(
+			func(a0 string, a1 int) (string, int, bool, float64, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP2R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, err := backoff.RetryP2R6(
+			func(a0 string, a1 int) (string, int, bool, float64, complex128, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP2R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, err := backoff.RetryP2R7(
+			func(a0 string, a1 int) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP2R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, r6, err := backoff.RetryP2R8(
+			func(a0 string, a1 int) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		if r6 != uint8(7) {
+			t.Errorf("r6 = %v, want %v", r6, uint8(7))
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP3R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryP3R1(
+			func(a0 string, a1 int, a2 bool) error {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP3R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, err := backoff.RetryP3R2(
+			func(a0 string, a1 int, a2 bool) (string, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return "hello", errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP3R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, err := backoff.RetryP3R3(
+			func(a0 string, a1 int, a2 bool) (string, int, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return "hello", 42, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP3R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, err := backoff.RetryP3R4(
+			func(a0 string, a1 int, a2 bool) (string, int, bool, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return "hello", 42, true, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP3R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, err := backoff.RetryP3R5(
+			func(a0 string, a1 int, a2 bool) (string, int, bool, float64, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP3R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, err := backoff.RetryP3R6(
+			func(a0 string, a1 int, a2 bool) (string, int, bool, float64, complex128, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP3R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, err := backoff.RetryP3R7(
+			func(a0 string, a1 int, a2 bool) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP3R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, r6, err := backoff.RetryP3R8(
+			func(a0 string, a1 int, a2 bool) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		if r6 != uint8(7) {
+			t.Errorf("r6 = %v, want %v", r6, uint8(7))
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP4R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryP4R1(
+			func(a0 string, a1 int, a2 bool, a3 float64) error {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP4R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, err := backoff.RetryP4R2(
+			func(a0 string, a1 int, a2 bool, a3 float64) (string, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return "hello", errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP4R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, err := backoff.RetryP4R3(
+			func(a0 string, a1 int, a2 bool, a3 float64) (string, int, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return "hello", 42, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP4R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, err := backoff.RetryP4R4(
+			func(a0 string, a1 int, a2 bool, a3 float64) (string, int, bool, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return "hello", 42, true, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP4R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, err := backoff.RetryP4R5(
+			func(a0 string, a1 int, a2 bool, a3 float64) (string, int, bool, float64, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP4R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, err := backoff.RetryP4R6(
+			func(a0 string, a1 int, a2 bool, a3 float64) (string, int, bool, float64, complex128, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP4R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, err := backoff.RetryP4R7(
+			func(a0 string, a1 int, a2 bool, a3 float64) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryP4R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, r6, err := backoff.RetryP4R8(
+			func(a0 string, a1 int, a2 bool, a3 float64) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		if r6 != uint8(7) {
+			t.Errorf("r6 = %v, want %v", r6, uint8(7))
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP0R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryContextP0R1(
+			ctx,
+			func(ctx context.Context) error {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				return errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP0R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, err := backoff.RetryContextP0R2(
+			ctx,
+			func(ctx context.Context) (string, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				return "hello", errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP0R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, err := backoff.RetryContextP0R3(
+			ctx,
+			func(ctx context.Context) (string, int, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				return "hello", 42, errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP0R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, err := backoff.RetryContextP0R4(
+			ctx,
+			func(ctx context.Context) (string, int, bool, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				return "hello", 42, true, errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP0R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, err := backoff.RetryContextP0R5(
+			ctx,
+			func(ctx context.Context) (string, int, bool, float64, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				return "hello", 42, true, 3.14, errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP0R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, err := backoff.RetryContextP0R6(
+			ctx,
+			func(ctx context.Context) (string, int, bool, float64, complex128, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP0R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, err := backoff.RetryContextP0R7(
+			ctx,
+			func(ctx context.Context) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP0R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, r6, err := backoff.RetryContextP0R8(
+			ctx,
+			func(ctx context.Context) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		if r6 != uint8(7) {
+			t.Errorf("r6 = %v, want %v", r6, uint8(7))
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP1R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryContextP1R1(
+			ctx,
+			func(ctx context.Context, a0 string) error {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP1R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, err := backoff.RetryContextP1R2(
+			ctx,
+			func(ctx context.Context, a0 string) (string, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return "hello", errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP1R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, err := backoff.RetryContextP1R3(
+			ctx,
+			func(ctx context.Context, a0 string) (string, int, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return "hello", 42, errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP1R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, err := backoff.RetryContextP1R4(
+			ctx,
+			func(ctx context.Context, a0 string) (string, int, bool, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return "hello", 42, true, errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP1R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, err := backoff.RetryContextP1R5(
+			ctx,
+			func(ctx context.Context, a0 string) (string, int, bool, float64, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP1R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, err := backoff.RetryContextP1R6(
+			ctx,
+			func(ctx context.Context, a0 string) (string, int, bool, float64, complex128, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP1R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, err := backoff.RetryContextP1R7(
+			ctx,
+			func(ctx context.Context, a0 string) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP1R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, r6, err := backoff.RetryContextP1R8(
+			ctx,
+			func(ctx context.Context, a0 string) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello",
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		if r6 != uint8(7) {
+			t.Errorf("r6 = %v, want %v", r6, uint8(7))
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP2R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryContextP2R1(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int) error {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP2R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, err := backoff.RetryContextP2R2(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int) (string, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return "hello", errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP2R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, err := backoff.RetryContextP2R3(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int) (string, int, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return "hello", 42, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP2R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, err := backoff.RetryContextP2R4(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int) (string, int, bool, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return "hello", 42, true, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP2R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, err := backoff.RetryContextP2R5(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int) (string, int, bool, float64, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP2R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, err := backoff.RetryContextP2R6(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int) (string, int, bool, float64, complex128, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP2R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, err := backoff.RetryContextP2R7(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP2R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, r6, err := backoff.RetryContextP2R8(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		if r6 != uint8(7) {
+			t.Errorf("r6 = %v, want %v", r6, uint8(7))
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP3R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryContextP3R1(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool) error {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP3R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, err := backoff.RetryContextP3R2(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool) (string, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return "hello", errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP3R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, err := backoff.RetryContextP3R3(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool) (string, int, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return "hello", 42, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP3R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, err := backoff.RetryContextP3R4(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool) (string, int, bool, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return "hello", 42, true, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP3R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, err := backoff.RetryContextP3R5(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool) (string, int, bool, float64, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP3R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, err := backoff.RetryContextP3R6(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool) (string, int, bool, float64, complex128, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP3R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, err := backoff.RetryContextP3R7(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP3R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, r6, err := backoff.RetryContextP3R8(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		if r6 != uint8(7) {
+			t.Errorf("r6 = %v, want %v", r6, uint8(7))
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP4R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryContextP4R1(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool, a3 float64) error {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP4R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, err := backoff.RetryContextP4R2(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool, a3 float64) (string, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return "hello", errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP4R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, err := backoff.RetryContextP4R3(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool, a3 float64) (string, int, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return "hello", 42, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP4R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, err := backoff.RetryContextP4R4(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool, a3 float64) (string, int, bool, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return "hello", 42, true, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP4R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, err := backoff.RetryContextP4R5(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool, a3 float64) (string, int, bool, float64, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP4R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, err := backoff.RetryContextP4R6(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool, a3 float64) (string, int, bool, float64, complex128, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP4R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, err := backoff.RetryContextP4R7(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool, a3 float64) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+	t.Run("RetryContextP4R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(time.Millisecond), backoff.MaxInterval(time.Millisecond), backoff.MaxRetries(1)}
+		n := 0
+		r0, r1, r2, r3, r4, r5, r6, err := backoff.RetryContextP4R8(
+			ctx,
+			func(ctx context.Context, a0 string, a1 int, a2 bool, a3 float64) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				if ctx.Value(genCtxKey{}) != "value" {
+					t.Errorf("ctx.Value(genCtxKey{}) = %v, want %v", ctx.Value(genCtxKey{}), "value")
+				}
+				if a0 != "hello" {
+					t.Errorf("a0 = %v, want %v", a0, "hello")
+				}
+				if a1 != 42 {
+					t.Errorf("a1 = %v, want %v", a1, 42)
+				}
+				if a2 != true {
+					t.Errorf("a2 = %v, want %v", a2, true)
+				}
+				if a3 != 3.14 {
+					t.Errorf("a3 = %v, want %v", a3, 3.14)
+				}
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		if !errors.Is(err, errGen) {
+			t.Errorf("err = %v, want %v", err, errGen)
+		}
+		if r0 != "hello" {
+			t.Errorf("r0 = %v, want %v", r0, "hello")
+		}
+		if r1 != 42 {
+			t.Errorf("r1 = %v, want %v", r1, 42)
+		}
+		if r2 != true {
+			t.Errorf("r2 = %v, want %v", r2, true)
+		}
+		if r3 != 3.14 {
+			t.Errorf("r3 = %v, want %v", r3, 3.14)
+		}
+		if r4 != 3+4i {
+			t.Errorf("r4 = %v, want %v", r4, 3+4i)
+		}
+		if r5 != 'x' {
+			t.Errorf("r5 = %v, want %v", r5, 'x')
+		}
+		if r6 != uint8(7) {
+			t.Errorf("r6 = %v, want %v", r6, uint8(7))
+		}
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		if n != 2 {
+			t.Errorf("n = %d, want 2", n)
+		}
+	})
+
+}
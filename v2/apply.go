@@ -4,14 +4,15 @@ import (
 	"github.com/cenkalti/backoff/v4"
 )
 
-// Apply applies options to exp, returns it as [backoff].[BackOff].
-//
-// [backoff]: https://pkg.go.dev/github.com/cenkalti/backoff/v4
-// [BackOff]: https://pkg.go.dev/github.com/cenkalti/backoff/v4#BackOff
-func Apply(exp *backoff.ExponentialBackOff, options ...Option) backoff.BackOff {
+// Apply applies options to exp, returns it as [BackOff].
+func Apply(exp *backoff.ExponentialBackOff, options ...Option) BackOff {
+	return apply(exp, options).build()
+}
+
+func apply(exp *backoff.ExponentialBackOff, options []Option) *builder {
 	bu := &builder{exp: exp}
 	for _, opt := range options {
 		opt(bu)
 	}
-	return bu.build()
+	return bu
 }
@@ -0,0 +1,88 @@
+package backoff
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+// Attempt is the information of an attempt yielded by [Attempts].
+type Attempt struct {
+	// Number is the number of the attempt, starting from 1.
+	Number int
+
+	// Delay is the duration slept before the attempt. It is zero for the first attempt.
+	Delay time.Duration
+
+	// Elapsed is the duration since the first attempt started.
+	Elapsed time.Duration
+
+	// Err is the error of the options, such as [ErrUnknownPolicy].
+	// If Err is not nil, the attempt is the only one yielded, and should not be performed.
+	Err error
+}
+
+// Attempts returns an iterator of attempts, sleeping between iterations according to BackOff.
+//
+// BackOff is created with options as the Retry* helpers do, and [WithBudget] is applied before each retry.
+// The options about the errors and the results, such as [RetryIf], [Logger], [WithMetrics], [WithTracer],
+// [Events] and [Operation], have no effect, because Attempts does not know the result of each attempt.
+//
+// The first attempt is yielded immediately.
+// The iteration ends when the loop breaks, BackOff stops, the budget is exhausted, or ctx is done while sleeping.
+// If the options are invalid, the first attempt is yielded with Err.
+//
+//	for a := range backoff.Attempts(ctx, backoff.MaxRetries(3)) {
+//		if a.Err != nil {
+//			return a.Err
+//		}
+//		if err = fn(); err == nil {
+//			break
+//		}
+//	}
+func Attempts(ctx context.Context, options ...Option) iter.Seq[Attempt] {
+	return func(yield func(Attempt) bool) {
+		bu := begin(options)
+		defer bu.release()
+		if bu.err != nil {
+			yield(Attempt{Number: 1, Err: bu.err})
+			return
+		}
+		b := backoff.WithContext(bu.build(), ctx)
+
+		start := time.Now()
+		a := Attempt{Number: 1}
+		var timer *time.Timer
+		defer func() {
+			if timer != nil {
+				stopTimer(timer)
+			}
+		}()
+		for {
+			if !yield(a) {
+				return
+			}
+
+			next := b.NextBackOff()
+			if next == backoff.Stop || (bu.budget != nil && !bu.budget.take()) {
+				return
+			}
+			if timer == nil {
+				timer = startTimer(next)
+			} else {
+				timer.Reset(next)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+
+			a.Number++
+			a.Delay = next
+			a.Elapsed = time.Since(start)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package backoff_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestAttempts(t *testing.T) {
+	t.Run("max", func(t *testing.T) {
+		var numbers []int
+		for a := range backoff.Attempts(
+			context.Background(),
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(3),
+		) {
+			numbers = append(numbers, a.Number)
+		}
+		// 最初の1回と最大 MaxRetries 回
+		assert.Equal(t, []int{1, 2, 3, 4}, numbers)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		n := 0
+		for a := range backoff.Attempts(
+			context.Background(),
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+		) {
+			n++
+			if a.Number == 2 {
+				break
+			}
+		}
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel() // キャンセル
+
+		n := 0
+		for range backoff.Attempts(ctx, backoff.InitialInterval(1), backoff.MaxInterval(1)) {
+			n++
+		}
+		// 最初の1回は必ず実行される
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("budget", func(t *testing.T) {
+		n := 0
+		for range backoff.Attempts(
+			context.Background(),
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(5),
+			backoff.WithBudget(backoff.NewBudget(1)),
+		) {
+			n++
+		}
+		// 予算の1回だけリトライする
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("unknown policy", func(t *testing.T) {
+		var attempts []backoff.Attempt
+		for a := range backoff.Attempts(context.Background(), backoff.UsePolicy("test-no-such-policy")) {
+			attempts = append(attempts, a)
+		}
+		// エラーを持つ1回だけ
+		require.Len(t, attempts, 1)
+		assert.ErrorIs(t, attempts[0].Err, backoff.ErrUnknownPolicy)
+	})
+}
@@ -0,0 +1,47 @@
+package backoff
+
+import (
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+// BackOff is the policy of the delays between the attempts.
+//
+// NextBackOff returns the delay before the next attempt, or [DefaultStop] to stop retrying.
+// Reset restarts the policy from the beginning.
+//
+// BackOff does not decouple this package from "github.com/cenkalti/backoff/v4".
+// The method set is the same as [backoff.BackOff] of it, and [FromCenkalti] and [ToCenkalti] convert without wrapping.
+// The API below still uses the types and the values of it directly.
+//
+//   - [Apply] takes [backoff.ExponentialBackOff].
+//   - [Clock] takes [backoff.Clock].
+//   - [DefaultStop] and the other Default* constants are those of it.
+//   - The errors wrapped by [backoff.Permanent] of it are not retried.
+type BackOff interface {
+	NextBackOff() time.Duration
+	Reset()
+}
+
+// Introspector is the optional interface of BackOff reporting its state.
+//
+// [backoff.ExponentialBackOff] implements it.
+type Introspector interface {
+	// GetElapsedTime returns the elapsed time since the BackOff was reset.
+	GetElapsedTime() time.Duration
+}
+
+// FromCenkalti returns b as [BackOff].
+//
+// b is not wrapped, so that the optional interfaces such as [Introspector] implemented by b are kept.
+func FromCenkalti(b backoff.BackOff) BackOff {
+	return b
+}
+
+// ToCenkalti returns b as [backoff.BackOff].
+//
+// b is not wrapped, so that the optional interfaces such as [Introspector] implemented by b are kept.
+func ToCenkalti(b BackOff) backoff.BackOff {
+	return b
+}
@@ -0,0 +1,132 @@
+package backoffhttp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/takumakei/go-backoff/v2/backoffnet"
+)
+
+// maxErrorBodyBytes is the maximum number of bytes of the body of a response of unexpected status kept by [Check].
+const maxErrorBodyBytes = 64 << 10
+
+// StatusError is the error returned by [Check] for a response of unexpected status.
+//
+// StatusError implements RetryAfter() time.Duration,
+// so that the Retry* helpers wait at least the duration of the Retry-After header.
+type StatusError struct {
+	// Response is the response of unexpected status.
+	// [Check] replaces its body by the first 64KiB of it read in memory.
+	// [Transport] drains and closes it before the next attempt, or returns it as the last response.
+	Response *http.Response
+}
+
+func (e *StatusError) Error() string {
+	return "backoffhttp: unexpected status: " + e.Response.Status
+}
+
+// RetryAfter returns the duration specified by the Retry-After header of the response, or zero.
+func (e *StatusError) RetryAfter() time.Duration {
+	d, _ := RetryAfter(e.Response)
+	return d
+}
+
+// Check converts the result of an HTTP round trip into the result for the Retry* helpers.
+//
+// If err is nil and the status code of resp is 4xx or 5xx,
+// Check reads the first 64KiB of the body of resp and closes it, so that the connection can be reused,
+// and returns resp with the body replaced by the bytes read, and [*StatusError].
+// The error payload is readable from the body of resp up to the limit.
+// Otherwise, Check returns resp and err as they are.
+//
+// Check is meant to be used with [Retryable] like below.
+//
+//	resp, err := backoff.RetryR2(
+//		func() (*http.Response, error) { return backoffhttp.Check(client.Do(req)) },
+//		backoff.RetryIf(backoffhttp.Retryable),
+//	)
+func Check(resp *http.Response, err error) (*http.Response, error) {
+	if err != nil || resp.StatusCode < 400 {
+		return resp, err
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+	discard(resp)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, &StatusError{Response: resp}
+}
+
+// Retryable reports whether err should be retried according to [Classify].
+//
+// Retryable is meant to be passed to [backoff.RetryIf].
+func Retryable(err error) bool {
+	var se *StatusError
+	if errors.As(err, &se) {
+		retry, _ := Classify(se.Response, nil)
+		return retry
+	}
+	retry, _ := Classify(nil, err)
+	return retry
+}
+
+// Classify reports whether the result of an HTTP round trip should be retried,
+// and the delay specified by the Retry-After header of resp.
+//
+//   - errors are retryable if [backoffnet.Retryable] reports so, except context cancellation.
+//     Other errors such as certificate errors and malformed URLs are permanent.
+//   - 5xx are retryable.
+//   - 4xx are permanent except 408, 425 and 429.
+//   - others are not errors, and not to be retried.
+func Classify(resp *http.Response, err error) (retry bool, after time.Duration) {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false, 0
+		}
+		return backoffnet.Retryable(err), 0
+	}
+	if resp == nil {
+		return false, 0
+	}
+	after, _ = RetryAfter(resp)
+	switch code := resp.StatusCode; {
+	case code >= 500:
+		return true, after
+	case code == http.StatusRequestTimeout, code == http.StatusTooEarly, code == http.StatusTooManyRequests:
+		return true, after
+	}
+	return false, after
+}
+
+// RetryAfter returns the duration specified by the Retry-After header of resp,
+// in either delay-seconds or HTTP-date.
+func RetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	return parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+}
+
+// parseRetryAfter parses the value of Retry-After header, in either delay-seconds or HTTP-date, relative to now.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if n < 0 {
+			return 0, false
+		}
+		return time.Duration(n) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := t.Sub(now)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
@@ -0,0 +1,132 @@
+package backoffhttp_test
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takumakei/go-backoff/v2"
+	"github.com/takumakei/go-backoff/v2/backoffhttp"
+)
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		code  int
+		retry bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusNotModified, false},
+		{http.StatusBadRequest, false},
+		{http.StatusNotFound, false},
+		{http.StatusRequestTimeout, true},
+		{http.StatusTooEarly, true},
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusServiceUnavailable, true},
+	}
+	for _, tt := range tests {
+		retry, _ := backoffhttp.Classify(&http.Response{StatusCode: tt.code}, nil)
+		assert.Equal(t, tt.retry, retry, tt.code)
+	}
+
+	retry, _ := backoffhttp.Classify(nil, &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED})
+	assert.True(t, retry)
+	retry, _ = backoffhttp.Classify(nil, context.Canceled)
+	assert.False(t, retry)
+	retry, _ = backoffhttp.Classify(nil, context.DeadlineExceeded)
+	assert.False(t, retry)
+	// 接続エラー以外はリトライしない
+	retry, _ = backoffhttp.Classify(nil, x509.UnknownAuthorityError{})
+	assert.False(t, retry)
+	retry, _ = backoffhttp.Classify(nil, errors.New("malformed HTTP response"))
+	assert.False(t, retry)
+}
+
+func TestRetryAfter(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	_, ok := backoffhttp.RetryAfter(resp)
+	assert.False(t, ok)
+
+	resp.Header.Set("Retry-After", "120")
+	d, ok := backoffhttp.RetryAfter(resp)
+	assert.True(t, ok)
+	assert.Equal(t, 120*time.Second, d)
+
+	resp.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	d, ok = backoffhttp.RetryAfter(resp)
+	assert.True(t, ok)
+	assert.InDelta(t, float64(time.Hour), float64(d), float64(2*time.Second))
+
+	resp.Header.Set("Retry-After", "Wed, 21 Oct 2015 07:28:00 GMT")
+	d, ok = backoffhttp.RetryAfter(resp)
+	assert.True(t, ok)
+	assert.Zero(t, d)
+
+	resp.Header.Set("Retry-After", "soon")
+	_, ok = backoffhttp.RetryAfter(resp)
+	assert.False(t, ok)
+}
+
+func TestRetryable(t *testing.T) {
+	t.Run("retry", func(t *testing.T) {
+		var n int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&n, 1) < 3 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}))
+		defer srv.Close()
+
+		resp, err := backoff.RetryR2(
+			func() (*http.Response, error) { return backoffhttp.Check(http.Get(srv.URL)) },
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(5),
+			backoff.RetryIf(backoffhttp.Retryable),
+		)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int32(3), n)
+	})
+
+	t.Run("permanent", func(t *testing.T) {
+		var n int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&n, 1)
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, "no such item")
+		}))
+		defer srv.Close()
+
+		resp, err := backoff.RetryR2(
+			func() (*http.Response, error) { return backoffhttp.Check(http.Get(srv.URL)) },
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(5),
+			backoff.RetryIf(backoffhttp.Retryable),
+		)
+		var se *backoffhttp.StatusError
+		require.ErrorAs(t, err, &se)
+		assert.Equal(t, http.StatusNotFound, se.Response.StatusCode)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, int32(1), n)
+
+		// エラーの本文を読める
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "no such item", string(body))
+		assert.NoError(t, resp.Body.Close())
+	})
+}
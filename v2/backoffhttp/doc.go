@@ -0,0 +1,2 @@
+// Package backoffhttp provides net/http integrations for "github.com/takumakei/go-backoff/v2".
+package backoffhttp
@@ -0,0 +1,159 @@
+package backoffhttp
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	cenkalti "github.com/cenkalti/backoff/v4"
+	"github.com/takumakei/go-backoff/v2"
+	"github.com/takumakei/go-backoff/v2/backoffnet"
+)
+
+// DefaultStatusCodes is the status codes retried by [Transport] when StatusCodes is nil.
+var DefaultStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// maxDrainBytes is the maximum number of bytes read from the body of a discarded response
+// so that the underlying connection can be reused.
+const maxDrainBytes = 4 << 10
+
+// Transport is an [http.RoundTripper] that retries requests by [backoff.RetryContextR2] with Options.
+//
+// Only replayable requests are retried, that is, requests with an idempotent method
+// or an Idempotency-Key header, and without a body or with GetBody to rewind it.
+// Other requests are sent once.
+//
+// By default, a request is retried when Base returns a connection error classified by [backoffnet.Retryable],
+// or when the status code of the response is one of StatusCodes.
+// The other errors such as certificate errors are returned immediately.
+// The response of a status code in StatusCodes is passed to [backoff.RetryIf] as [*StatusError],
+// so that a RetryIf in Options overrides the default.
+// The delay before the next attempt is extended to the Retry-After header of the response if any.
+type Transport struct {
+	// Base is the RoundTripper used to send each attempt.
+	// If nil, http.DefaultTransport is used.
+	Base http.RoundTripper
+
+	// Options is the options for creating BackOff per request.
+	// They are applied to every request, which may run concurrently,
+	// so use [backoff.WithBackOffFunc] instead of [backoff.WithBackOff] sharing one BackOff.
+	Options []backoff.Option
+
+	// StatusCodes is the status codes to retry.
+	// If nil, DefaultStatusCodes is used.
+	StatusCodes []int
+}
+
+var _ http.RoundTripper = (*Transport)(nil)
+
+// RoundTrip implements [http.RoundTripper].
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !replayable(req) {
+		return t.base().RoundTrip(req)
+	}
+
+	// last is the response of the previous attempt, discarded before the next attempt.
+	var last *http.Response
+	attempts := 0
+	options := append([]backoff.Option{backoff.RetryIf(t.retryable)}, t.Options...)
+	resp, err := backoff.RetryContextR2(req.Context(), func() (*http.Response, error) {
+		r := req
+		if attempts++; attempts > 1 {
+			if last != nil {
+				discard(last)
+				last = nil
+			}
+			var err error
+			if r, err = rewind(req); err != nil {
+				return nil, cenkalti.Permanent(err)
+			}
+		}
+		resp, err := t.base().RoundTrip(r)
+		if err != nil {
+			return nil, err
+		}
+		if t.retryStatus(resp.StatusCode) {
+			last = resp
+			return resp, &StatusError{Response: resp}
+		}
+		return resp, nil
+	}, options...)
+
+	var se *StatusError
+	switch {
+	case err == nil:
+		return resp, nil
+	case errors.As(err, &se) && se.Response == resp && req.Context().Err() == nil:
+		// The response of the last attempt is returned as it is.
+		return resp, nil
+	}
+	if last != nil {
+		discard(last)
+	}
+	return nil, err
+}
+
+// retryable is the default predicate of RetryIf.
+func (t *Transport) retryable(err error) bool {
+	var se *StatusError
+	if errors.As(err, &se) {
+		return true
+	}
+	return backoffnet.Retryable(err)
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+func (t *Transport) retryStatus(code int) bool {
+	codes := t.StatusCodes
+	if codes == nil {
+		codes = DefaultStatusCodes
+	}
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// replayable reports whether req can be sent again.
+func replayable(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != "" || req.Header.Get("X-Idempotency-Key") != ""
+}
+
+// rewind returns a shallow copy of req with a fresh body obtained by GetBody.
+func rewind(req *http.Request) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+	return r, nil
+}
+
+// discard drains and closes the body of resp.
+func discard(resp *http.Response) {
+	_, _ = io.CopyN(io.Discard, resp.Body, maxDrainBytes)
+	_ = resp.Body.Close()
+}
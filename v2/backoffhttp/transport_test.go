@@ -0,0 +1,295 @@
+package backoffhttp_test
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takumakei/go-backoff/v2"
+	"github.com/takumakei/go-backoff/v2/backoffhttp"
+)
+
+func TestTransport(t *testing.T) {
+	t.Run("status", func(t *testing.T) {
+		var n int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&n, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			io.WriteString(w, "ok")
+		}))
+		defer srv.Close()
+
+		client := &http.Client{Transport: &backoffhttp.Transport{
+			Options: []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(5)},
+		}}
+		resp, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "ok", string(body))
+		assert.Equal(t, int32(3), n)
+	})
+
+	t.Run("give up", func(t *testing.T) {
+		var n int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&n, 1)
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		client := &http.Client{Transport: &backoffhttp.Transport{
+			Options: []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(3)},
+		}}
+		resp, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		// 最後のレスポンスがそのまま返される
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+		assert.Equal(t, int32(4), n)
+	})
+
+	t.Run("not retried status", func(t *testing.T) {
+		var n int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&n, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+
+		client := &http.Client{Transport: &backoffhttp.Transport{
+			Options: []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(3)},
+		}}
+		resp, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		assert.Equal(t, int32(1), n)
+	})
+
+	t.Run("body", func(t *testing.T) {
+		var n int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(t, "hello", string(body))
+			if atomic.AddInt32(&n, 1) < 3 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+		}))
+		defer srv.Close()
+
+		client := &http.Client{Transport: &backoffhttp.Transport{
+			Options: []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(5)},
+		}}
+		req, err := http.NewRequest(http.MethodPut, srv.URL, strings.NewReader("hello"))
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int32(3), n)
+	})
+
+	t.Run("post", func(t *testing.T) {
+		var n int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&n, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		client := &http.Client{Transport: &backoffhttp.Transport{
+			Options: []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(3)},
+		}}
+		resp, err := client.Post(srv.URL, "text/plain", strings.NewReader("hello"))
+		require.NoError(t, err)
+		resp.Body.Close()
+		// POST はリトライしない
+		assert.Equal(t, int32(1), n)
+	})
+
+	t.Run("retry after", func(t *testing.T) {
+		var n int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&n, 1)
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		// 実際には待たず、待つ時間を確認したらキャンセルする
+		events := make(chan backoff.Event, 16)
+		client := &http.Client{Transport: &backoffhttp.Transport{
+			Options: []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(3), backoff.Events(events)},
+		}}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		require.NoError(t, err)
+
+		done := make(chan error, 1)
+		go func() {
+			resp, err := client.Do(req)
+			if err == nil {
+				resp.Body.Close()
+			}
+			done <- err
+		}()
+
+		for e := range events {
+			if e.Kind == backoff.EventSleeping {
+				assert.Equal(t, time.Second, e.Delay)
+				break
+			}
+		}
+		cancel()
+		assert.ErrorIs(t, <-done, context.Canceled)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&n))
+	})
+
+	t.Run("connection error", func(t *testing.T) {
+		var n int32
+		base := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			if atomic.AddInt32(&n, 1) < 3 {
+				return nil, &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+			}
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: r}, nil
+		})
+		client := &http.Client{Transport: &backoffhttp.Transport{
+			Base:    base,
+			Options: []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(5)},
+		}}
+		resp, err := client.Get("http://example.invalid/")
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, int32(3), n)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		var n int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&n, 1)%2 == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+		defer srv.Close()
+
+		// リクエストごとに BackOff を作るので、go test -race でも競合しない
+		client := &http.Client{Transport: &backoffhttp.Transport{
+			Options: []backoff.Option{
+				backoff.MaxRetries(5),
+				backoff.WithBackOffFunc(func() backoff.BackOff {
+					return backoff.New(backoff.InitialInterval(1), backoff.MaxInterval(1))
+				}),
+			},
+		}}
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := client.Get(srv.URL)
+				if assert.NoError(t, err) {
+					resp.Body.Close()
+				}
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("permanent error", func(t *testing.T) {
+		var n int32
+		base := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&n, 1)
+			return nil, x509.UnknownAuthorityError{}
+		})
+		client := &http.Client{Transport: &backoffhttp.Transport{
+			Base:    base,
+			Options: []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxElapsedTime(200 * time.Millisecond)},
+		}}
+		_, err := client.Get("http://example.invalid/")
+		var uae x509.UnknownAuthorityError
+		assert.ErrorAs(t, err, &uae)
+		// 接続エラー以外は1回だけ送信する
+		assert.Equal(t, int32(1), n)
+	})
+
+	t.Run("options override", func(t *testing.T) {
+		var n int32
+		base := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			atomic.AddInt32(&n, 1)
+			return nil, errors.New("custom")
+		})
+		client := &http.Client{Transport: &backoffhttp.Transport{
+			Base: base,
+			Options: []backoff.Option{
+				backoff.InitialInterval(1),
+				backoff.MaxInterval(1),
+				backoff.MaxRetries(2),
+				backoff.RetryIf(func(error) bool { return true }),
+			},
+		}}
+		_, err := client.Get("http://example.invalid/")
+		assert.Error(t, err)
+		// Options の RetryIf が既定の判定を上書きする
+		assert.Equal(t, int32(3), n)
+	})
+
+	t.Run("status not retried by RetryIf", func(t *testing.T) {
+		var n int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&n, 1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			io.WriteString(w, "unavailable")
+		}))
+		defer srv.Close()
+
+		client := &http.Client{Transport: &backoffhttp.Transport{
+			Options: []backoff.Option{backoff.RetryIf(func(error) bool { return false })},
+		}}
+		resp, err := client.Get(srv.URL)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, "unavailable", string(body))
+		assert.Equal(t, int32(1), n)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		require.NoError(t, err)
+
+		client := &http.Client{Transport: &backoffhttp.Transport{}}
+		_, err = client.Do(req)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
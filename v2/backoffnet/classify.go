@@ -0,0 +1,37 @@
+package backoffnet
+
+import (
+	"errors"
+	"net"
+	"syscall"
+)
+
+// Retryable reports whether err is expected to be resolved by dialing again,
+// that is, the peer is not up yet or temporarily unreachable.
+//
+//   - connection refused, reset, aborted, timed out, host unreachable and network unreachable are retryable.
+//   - timeouts reported by [net.Error] are retryable.
+//   - temporary failures of DNS are retryable.
+func Retryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	for _, errno := range []syscall.Errno{
+		syscall.ECONNREFUSED,
+		syscall.ECONNRESET,
+		syscall.ECONNABORTED,
+		syscall.ETIMEDOUT,
+		syscall.EHOSTUNREACH,
+		syscall.ENETUNREACH,
+	} {
+		if errors.Is(err, errno) {
+			return true
+		}
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,19 @@
+package backoffnet_test
+
+import (
+	"errors"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/takumakei/go-backoff/v2/backoffnet"
+)
+
+func TestRetryable(t *testing.T) {
+	assert.True(t, backoffnet.Retryable(&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}))
+	assert.True(t, backoffnet.Retryable(&net.DNSError{IsTemporary: true}))
+	assert.False(t, backoffnet.Retryable(&net.DNSError{IsNotFound: true}))
+	assert.False(t, backoffnet.Retryable(errors.New("unknown")))
+	assert.False(t, backoffnet.Retryable(nil))
+}
@@ -0,0 +1,56 @@
+package backoffnet
+
+import (
+	"context"
+	"net"
+
+	"github.com/takumakei/go-backoff/v2"
+)
+
+// ContextDialer is the interface implemented by [net.Dialer].
+type ContextDialer interface {
+	DialContext(ctx context.Context, network, addr string) (net.Conn, error)
+}
+
+// Dialer retries dialing under [backoff.RetryContextR2] with Options
+// while the error is classified as transient by [Retryable].
+//
+// The classifier can be replaced by passing [backoff.RetryIf] in Options.
+type Dialer struct {
+	// Base is the dialer used for each attempt.
+	// If nil, the zero value of net.Dialer is used.
+	Base ContextDialer
+
+	// Options is the options for retrying.
+	// They are applied to every dial, which may run concurrently,
+	// so use [backoff.WithBackOffFunc] instead of [backoff.WithBackOff] sharing one BackOff.
+	Options []backoff.Option
+}
+
+var _ ContextDialer = (*Dialer)(nil)
+
+// Dial connects to the address on the named network.
+func (d *Dialer) Dial(network, addr string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, addr)
+}
+
+// DialContext connects to the address on the named network using ctx.
+func (d *Dialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	base := d.Base
+	if base == nil {
+		base = &net.Dialer{}
+	}
+	options := append([]backoff.Option{backoff.RetryIf(Retryable)}, d.Options...)
+	return backoff.RetryContextR2(
+		ctx,
+		func() (net.Conn, error) { return base.DialContext(ctx, network, addr) },
+		options...,
+	)
+}
+
+// DialContext connects to the address on the named network using ctx, retrying with options.
+//
+// see: [Dialer]
+func DialContext(ctx context.Context, network, addr string, options ...backoff.Option) (net.Conn, error) {
+	return (&Dialer{Options: options}).DialContext(ctx, network, addr)
+}
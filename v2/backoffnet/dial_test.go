@@ -0,0 +1,95 @@
+package backoffnet_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takumakei/go-backoff/v2"
+	"github.com/takumakei/go-backoff/v2/backoffnet"
+)
+
+// closedAddr returns an address on which nothing listens.
+func closedAddr(t *testing.T) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	ln.Close()
+	return addr
+}
+
+type countingDialer struct {
+	n int32
+	net.Dialer
+}
+
+func (d *countingDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	atomic.AddInt32(&d.n, 1)
+	return d.Dialer.DialContext(ctx, network, addr)
+}
+
+func TestDialContext(t *testing.T) {
+	t.Run("late listener", func(t *testing.T) {
+		addr := closedAddr(t)
+
+		done := make(chan net.Listener)
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			ln, err := net.Listen("tcp", addr)
+			if err != nil {
+				close(done)
+				return
+			}
+			done <- ln
+		}()
+
+		conn, err := backoffnet.DialContext(
+			context.Background(), "tcp", addr,
+			backoff.InitialInterval(10*time.Millisecond),
+			backoff.MaxInterval(10*time.Millisecond),
+			backoff.MaxElapsedTime(5*time.Second),
+		)
+		ln, ok := <-done
+		if !ok {
+			t.Skip("could not listen on", addr)
+		}
+		defer ln.Close()
+		require.NoError(t, err)
+		conn.Close()
+	})
+
+	t.Run("refused", func(t *testing.T) {
+		base := &countingDialer{}
+		d := &backoffnet.Dialer{
+			Base: base,
+			Options: []backoff.Option{
+				backoff.InitialInterval(1),
+				backoff.MaxInterval(1),
+				backoff.MaxRetries(3),
+			},
+		}
+		_, err := d.DialContext(context.Background(), "tcp", closedAddr(t))
+		assert.ErrorIs(t, err, syscall.ECONNREFUSED)
+		assert.Equal(t, int32(4), base.n)
+	})
+
+	t.Run("permanent", func(t *testing.T) {
+		base := &countingDialer{}
+		d := &backoffnet.Dialer{
+			Base: base,
+			Options: []backoff.Option{
+				backoff.InitialInterval(1),
+				backoff.MaxInterval(1),
+				backoff.MaxRetries(3),
+			},
+		}
+		_, err := d.Dial("no-such-network", "127.0.0.1:1")
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), base.n)
+	})
+}
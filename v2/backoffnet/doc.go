@@ -0,0 +1,2 @@
+// Package backoffnet provides net integrations for "github.com/takumakei/go-backoff/v2".
+package backoffnet
@@ -0,0 +1,45 @@
+package backoffsql
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// SQLSTATE codes of transient errors.
+const (
+	SQLStateSerializationFailure = "40001"
+	SQLStateDeadlockDetected     = "40P01"
+)
+
+// MySQL error number of ER_LOCK_DEADLOCK.
+const MySQLErrLockDeadlock = 1213
+
+// Retryable reports whether err is a serialization failure or a deadlock, that is,
+// the transaction is expected to succeed when retried.
+//
+// err is classified by the SQLSTATE returned by the method SQLState() string,
+// which is implemented by the errors of github.com/lib/pq and github.com/jackc/pgx,
+// or by the message formatted by github.com/go-sql-driver/mysql.
+func Retryable(err error) bool {
+	var s interface{ SQLState() string }
+	if errors.As(err, &s) {
+		switch s.SQLState() {
+		case SQLStateSerializationFailure, SQLStateDeadlockDetected:
+			return true
+		}
+		return false
+	}
+	for ; err != nil; err = errors.Unwrap(err) {
+		if isMySQLError(err.Error(), MySQLErrLockDeadlock) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMySQLError reports whether msg is formatted as "Error <number>: ..." or "Error <number> (<sqlstate>): ...".
+func isMySQLError(msg string, number int) bool {
+	prefix := "Error " + strconv.Itoa(number)
+	return strings.HasPrefix(msg, prefix+":") || strings.HasPrefix(msg, prefix+" (")
+}
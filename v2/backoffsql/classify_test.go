@@ -0,0 +1,20 @@
+package backoffsql_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/takumakei/go-backoff/v2/backoffsql"
+)
+
+func TestRetryable(t *testing.T) {
+	assert.True(t, backoffsql.Retryable(sqlStateError("40001")))
+	assert.True(t, backoffsql.Retryable(fmt.Errorf("wrapped: %w", sqlStateError("40P01"))))
+	assert.False(t, backoffsql.Retryable(sqlStateError("23505")))
+	assert.True(t, backoffsql.Retryable(errors.New("Error 1213: Deadlock found when trying to get lock; try restarting transaction")))
+	assert.True(t, backoffsql.Retryable(errors.New("Error 1213 (40001): Deadlock found when trying to get lock; try restarting transaction")))
+	assert.False(t, backoffsql.Retryable(errors.New("Error 1062: Duplicate entry")))
+	assert.False(t, backoffsql.Retryable(nil))
+}
@@ -0,0 +1,2 @@
+// Package backoffsql provides database/sql integrations for "github.com/takumakei/go-backoff/v2".
+package backoffsql
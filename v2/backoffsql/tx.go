@@ -0,0 +1,31 @@
+package backoffsql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/takumakei/go-backoff/v2"
+)
+
+// RetryTx runs fn in a transaction begun by db.BeginTx with ctx and opts,
+// and commits it if fn returns nil, rolls it back otherwise.
+// The whole transaction is retried under [backoff.RetryContext] with options
+// while the error is classified as transient by [Retryable].
+//
+// The classifier can be replaced by passing [backoff.RetryIf] in options.
+func RetryTx(ctx context.Context, db *sql.DB, opts *sql.TxOptions, fn func(*sql.Tx) error, options ...backoff.Option) error {
+	options = append([]backoff.Option{backoff.RetryIf(Retryable)}, options...)
+	return backoff.RetryContext(ctx, func() error { return runTx(ctx, db, opts, fn) }, options...)
+}
+
+func runTx(ctx context.Context, db *sql.DB, opts *sql.TxOptions, fn func(*sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, opts)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
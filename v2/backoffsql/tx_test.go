@@ -0,0 +1,127 @@
+package backoffsql_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/takumakei/go-backoff/v2"
+	"github.com/takumakei/go-backoff/v2/backoffsql"
+)
+
+// fakeDB counts transactions of the connections created by the connector.
+type fakeDB struct {
+	commitErrs []error
+	commits    int
+	rollbacks  int
+}
+
+func (db *fakeDB) Connect(context.Context) (driver.Conn, error) { return fakeConn{db}, nil }
+func (db *fakeDB) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct{ db *fakeDB }
+
+func (c fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c fakeConn) Close() error                        { return nil }
+func (c fakeConn) Begin() (driver.Tx, error)           { return fakeTx(c), nil }
+
+type fakeTx struct{ db *fakeDB }
+
+func (tx fakeTx) Commit() error {
+	tx.db.commits++
+	if len(tx.db.commitErrs) > 0 {
+		err := tx.db.commitErrs[0]
+		tx.db.commitErrs = tx.db.commitErrs[1:]
+		return err
+	}
+	return nil
+}
+
+func (tx fakeTx) Rollback() error {
+	tx.db.rollbacks++
+	return nil
+}
+
+type sqlStateError string
+
+func (e sqlStateError) Error() string    { return "sqlstate " + string(e) }
+func (e sqlStateError) SQLState() string { return string(e) }
+
+var options = []backoff.Option{
+	backoff.InitialInterval(1),
+	backoff.MaxInterval(1),
+	backoff.MaxRetries(5),
+}
+
+func TestRetryTx(t *testing.T) {
+	t.Run("fn", func(t *testing.T) {
+		fake := &fakeDB{}
+		db := sql.OpenDB(fake)
+		defer db.Close()
+
+		n := 0
+		err := backoffsql.RetryTx(context.Background(), db, nil, func(tx *sql.Tx) error {
+			n++
+			if n < 3 {
+				return sqlStateError(backoffsql.SQLStateDeadlockDetected)
+			}
+			return nil
+		}, options...)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.Equal(t, 2, fake.rollbacks)
+		assert.Equal(t, 1, fake.commits)
+	})
+
+	t.Run("commit", func(t *testing.T) {
+		fake := &fakeDB{commitErrs: []error{sqlStateError(backoffsql.SQLStateSerializationFailure)}}
+		db := sql.OpenDB(fake)
+		defer db.Close()
+
+		n := 0
+		err := backoffsql.RetryTx(context.Background(), db, nil, func(tx *sql.Tx) error {
+			n++
+			return nil
+		}, options...)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, 2, fake.commits)
+	})
+
+	t.Run("permanent", func(t *testing.T) {
+		fake := &fakeDB{}
+		db := sql.OpenDB(fake)
+		defer db.Close()
+
+		n := 0
+		fatal := sqlStateError("23505")
+		err := backoffsql.RetryTx(context.Background(), db, nil, func(tx *sql.Tx) error {
+			n++
+			return fatal
+		}, options...)
+		assert.ErrorIs(t, err, fatal)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, 1, fake.rollbacks)
+	})
+
+	t.Run("classifier", func(t *testing.T) {
+		fake := &fakeDB{}
+		db := sql.OpenDB(fake)
+		defer db.Close()
+
+		n := 0
+		busy := errors.New("busy")
+		err := backoffsql.RetryTx(context.Background(), db, nil, func(tx *sql.Tx) error {
+			n++
+			if n < 3 {
+				return busy
+			}
+			return nil
+		}, append(options, backoff.RetryIf(func(err error) bool { return errors.Is(err, busy) }))...)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, n)
+	})
+}
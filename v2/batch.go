@@ -0,0 +1,45 @@
+package backoff
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrBatchIncomplete is returned by [RetryBatch] when BackOff stops while some items have not succeeded,
+// and the last attempt returned no error.
+var ErrBatchIncomplete = errors.New("backoff: batch incomplete")
+
+// RetryBatch calls fn with items, and retries only the failed items until all of them succeed or BackOff stops.
+//
+// BackOff is created by [NewContext] with options and ctx.
+//
+// fn returns the subset of the given items that failed.
+// If fn returns an error with no failed items, all the given items are regarded as failed.
+// If fn returns failed items with nil error, they are retried as well.
+// The error returned by fn is classified as [Retry], e.g. by [RetryIf].
+//
+// RetryBatch returns the items that never succeeded and the error of the last attempt,
+// [ErrBatchIncomplete], or the error of ctx. It returns nil and nil if all the items succeeded.
+func RetryBatch[T any](ctx context.Context, items []T, fn func(context.Context, []T) (failed []T, err error), options ...Option) ([]T, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	pending := items
+	err := retry(ctx, func(ctx context.Context) error {
+		failed, err := fn(ctx, pending)
+		if len(failed) > 0 {
+			pending = failed
+		} else if err == nil {
+			pending = nil
+		}
+		if err == nil && len(failed) > 0 {
+			return ErrBatchIncomplete
+		}
+		return err
+	}, options)
+	if err == nil {
+		return nil, nil
+	}
+	return pending, err
+}
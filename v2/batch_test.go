@@ -0,0 +1,118 @@
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cenkalti "github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestRetryBatch(t *testing.T) {
+	t.Run("partial", func(t *testing.T) {
+		var calls [][]int
+		failed, err := backoff.RetryBatch(
+			context.Background(),
+			[]int{1, 2, 3, 4, 5},
+			func(ctx context.Context, items []int) ([]int, error) {
+				calls = append(calls, items)
+				// 各呼び出しで先頭の要素だけ成功する
+				return items[1:], nil
+			},
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+		)
+		assert.NoError(t, err)
+		assert.Nil(t, failed)
+		// 失敗した要素だけ再送される
+		assert.Equal(t, [][]int{{1, 2, 3, 4, 5}, {2, 3, 4, 5}, {3, 4, 5}, {4, 5}, {5}}, calls)
+	})
+
+	t.Run("incomplete", func(t *testing.T) {
+		n := 0
+		failed, err := backoff.RetryBatch(
+			context.Background(),
+			[]string{"a", "b", "c"},
+			func(ctx context.Context, items []string) ([]string, error) {
+				n++
+				return []string{"b"}, nil
+			},
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(2),
+		)
+		assert.ErrorIs(t, err, backoff.ErrBatchIncomplete)
+		assert.Equal(t, []string{"b"}, failed)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 3, n)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		never := errors.New("never")
+		var calls [][]int
+		failed, err := backoff.RetryBatch(
+			context.Background(),
+			[]int{1, 2, 3},
+			func(ctx context.Context, items []int) ([]int, error) {
+				calls = append(calls, items)
+				if len(calls) == 1 {
+					// 失敗した要素なしのエラーは全体の失敗
+					return nil, never
+				}
+				return items[2:], never
+			},
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(1),
+		)
+		assert.ErrorIs(t, err, never)
+		assert.Equal(t, []int{3}, failed)
+		assert.Equal(t, [][]int{{1, 2, 3}, {1, 2, 3}}, calls)
+	})
+
+	t.Run("permanent", func(t *testing.T) {
+		fatal := errors.New("fatal")
+		n := 0
+		failed, err := backoff.RetryBatch(
+			context.Background(),
+			[]int{1, 2},
+			func(ctx context.Context, items []int) ([]int, error) {
+				n++
+				return []int{2}, cenkalti.Permanent(fatal)
+			},
+		)
+		assert.ErrorIs(t, err, fatal)
+		assert.Equal(t, []int{2}, failed)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel() // キャンセル
+
+		n := 0
+		failed, err := backoff.RetryBatch(
+			ctx,
+			[]int{1, 2},
+			func(ctx context.Context, items []int) ([]int, error) {
+				n++
+				return items, nil
+			},
+		)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, []int{1, 2}, failed)
+		// fn は必ず1回実行される
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		failed, err := backoff.RetryBatch(context.Background(), nil, func(context.Context, []int) ([]int, error) {
+			t.Fatal("must not be called")
+			return nil, nil
+		})
+		assert.NoError(t, err)
+		assert.Nil(t, failed)
+	})
+}
@@ -0,0 +1,130 @@
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestRetryAllocs(t *testing.T) {
+	// 1回目で成功した場合はアロケーションしない
+	tests := map[string]func(){
+		"Retry": func() { _ = backoff.Retry(func() error { return nil }, backoff.MaxRetries(3)) },
+		"RetryContext": func() {
+			_ = backoff.RetryContext(context.Background(), func() error { return nil }, backoff.MaxRetries(3))
+		},
+		"RetryR2": func() { _, _ = backoff.RetryR2(func() (int, error) { return 1, nil }, backoff.MaxRetries(3)) },
+		"RetryR3": func() {
+			_, _, _ = backoff.RetryR3(func() (int, int, error) { return 1, 2, nil }, backoff.MaxRetries(3))
+		},
+		"RetryR4": func() {
+			_, _, _, _ = backoff.RetryR4(func() (int, int, int, error) { return 1, 2, 3, nil }, backoff.MaxRetries(3))
+		},
+		"RetryR5": func() {
+			_, _, _, _, _ = backoff.RetryR5(func() (int, int, int, int, error) { return 1, 2, 3, 4, nil }, backoff.MaxRetries(3))
+		},
+		"RetryContextR2": func() { _, _ = backoff.RetryContextR2(context.Background(), func() (int, error) { return 1, nil }) },
+	}
+	for name, fn := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Zero(t, testing.AllocsPerRun(100, fn))
+		})
+	}
+}
+
+func BenchmarkRetry(b *testing.B) {
+	b.Run("success", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = backoff.Retry(func() error { return nil })
+		}
+	})
+
+	b.Run("options", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = backoff.Retry(
+				func() error { return nil },
+				backoff.InitialInterval(1),
+				backoff.MaxInterval(1),
+				backoff.MaxRetries(3),
+			)
+		}
+	})
+
+	b.Run("retry", func(b *testing.B) {
+		b.ReportAllocs()
+		temporary := errors.New("temporary")
+		for i := 0; i < b.N; i++ {
+			n := 0
+			_ = backoff.Retry(
+				func() error {
+					if n++; n < 2 {
+						return temporary
+					}
+					return nil
+				},
+				backoff.InitialInterval(1),
+				backoff.MaxInterval(1),
+			)
+		}
+	})
+}
+
+func BenchmarkRetryR2(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = backoff.RetryR2(func() (int, error) { return 1, nil }, backoff.MaxRetries(3))
+	}
+}
+
+func BenchmarkRetryR3(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = backoff.RetryR3(func() (int, int, error) { return 1, 2, nil }, backoff.MaxRetries(3))
+	}
+}
+
+func BenchmarkRetryR4(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _, _, _ = backoff.RetryR4(func() (int, int, int, error) { return 1, 2, 3, nil }, backoff.MaxRetries(3))
+	}
+}
+
+func BenchmarkRetryR5(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _, _, _, _ = backoff.RetryR5(func() (int, int, int, int, error) { return 1, 2, 3, 4, nil }, backoff.MaxRetries(3))
+	}
+}
+
+func BenchmarkRetryParallel(b *testing.B) {
+	// 失敗を繰り返す多数の並行リトライループ
+	b.ReportAllocs()
+	temporary := errors.New("temporary")
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			n := 0
+			_ = backoff.Retry(
+				func() error {
+					if n++; n < 4 {
+						return temporary
+					}
+					return nil
+				},
+				backoff.InitialInterval(time.Microsecond),
+				backoff.MaxInterval(time.Microsecond),
+			)
+		}
+	})
+	runtime.ReadMemStats(&after)
+	b.ReportMetric(float64(after.NumGC-before.NumGC)/float64(b.N)*1e6, "gc/1M-op")
+}
@@ -0,0 +1,43 @@
+package backoff
+
+import "sync/atomic"
+
+// Budget is the number of retries shared by retry operations.
+//
+// A retry operation using a Budget by [WithBudget] gives up when the Budget is exhausted, even if BackOff does not stop.
+// It is safe for concurrent use.
+type Budget struct {
+	n atomic.Int64
+}
+
+// NewBudget returns a Budget of retries.
+func NewBudget(retries int64) *Budget {
+	b := &Budget{}
+	b.n.Store(retries)
+	return b
+}
+
+// Remaining returns the number of the remaining retries.
+func (b *Budget) Remaining() int64 {
+	return b.n.Load()
+}
+
+// take consumes a retry, reports whether it is available.
+func (b *Budget) take() bool {
+	for {
+		n := b.n.Load()
+		if n <= 0 {
+			return false
+		}
+		if b.n.CompareAndSwap(n, n-1) {
+			return true
+		}
+	}
+}
+
+// WithBudget consumes a retry of b before each retry, and gives up when b is exhausted.
+//
+// It is applied by the Retry* helpers and [Attempts], and ignored by [New] and [Apply].
+func WithBudget(b *Budget) Option {
+	return func(bu *builder) { bu.budget = b }
+}
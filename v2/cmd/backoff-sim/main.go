@@ -0,0 +1,163 @@
+// Command backoff-sim prints the delay schedule of a retry policy.
+//
+//	backoff-sim -policy 'exp(initial=100ms,max=30s,mult=2,jitter=0.3)'
+//
+// With -clients, backoff-sim simulates the clients retrying against a server that keeps failing,
+// and prints the histogram of arrivals at the server instead.
+//
+//	backoff-sim -policy 'exp(initial=1s,jitter=0.5)' -clients 1000 -spread 1s -bucket 1s -format csv
+//
+// The output format is one of table, csv and json. Durations are in seconds in csv and json.
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("backoff-sim", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	spec := fs.String("policy", "exp()", "retry policy spec")
+	limit := fs.Int("attempts", 20, "max number of delays per client")
+	clients := fs.Int("clients", 0, "number of clients to simulate, 0 to print the schedule")
+	spread := fs.Duration("spread", 0, "clients start at random time in [0, spread)")
+	width := fs.Duration("bucket", time.Second, "width of the buckets of the histogram")
+	format := fs.String("format", "table", "output format: table, csv or json")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	options, err := backoff.Parse(*spec)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+	if *width <= 0 || *limit < 0 || *clients < 0 || *spread < 0 {
+		fmt.Fprintln(stderr, "backoff-sim: -attempts, -clients, -spread must not be negative, -bucket must be positive")
+		return 2
+	}
+
+	var w output
+	switch *format {
+	case "table":
+		w = &tableOutput{w: tabwriter.NewWriter(stdout, 0, 0, 2, ' ', tabwriter.AlignRight)}
+	case "csv":
+		w = &csvOutput{w: csv.NewWriter(stdout)}
+	case "json":
+		w = &jsonOutput{w: stdout}
+	default:
+		fmt.Fprintf(stderr, "backoff-sim: unknown format %q\n", *format)
+		return 2
+	}
+
+	if *clients > 0 {
+		var buckets []bucket
+		if buckets, err = simulate(options, *clients, *limit, *spread, *width); err == nil {
+			err = w.histogram(buckets)
+		}
+	} else {
+		err = w.schedule(schedule(options, *limit))
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
+
+type output interface {
+	schedule([]row) error
+	histogram([]bucket) error
+}
+
+type tableOutput struct{ w *tabwriter.Writer }
+
+func (o *tableOutput) schedule(rows []row) error {
+	fmt.Fprintln(o.w, "attempt\tdelay\tmin\tmax\telapsed\t")
+	for _, r := range rows {
+		fmt.Fprintf(o.w, "%d\t%v\t%v\t%v\t%v\t\n", r.Attempt, r.Delay, r.Min, r.Max, r.Elapsed)
+	}
+	return o.w.Flush()
+}
+
+func (o *tableOutput) histogram(buckets []bucket) error {
+	fmt.Fprintln(o.w, "start\tarrivals\trate\t")
+	for _, b := range buckets {
+		fmt.Fprintf(o.w, "%v\t%d\t%.2f/s\t\n", b.Start, b.Arrivals, b.Rate)
+	}
+	return o.w.Flush()
+}
+
+type csvOutput struct{ w *csv.Writer }
+
+func seconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64)
+}
+
+func (o *csvOutput) schedule(rows []row) error {
+	o.w.Write([]string{"attempt", "delay", "min", "max", "elapsed"})
+	for _, r := range rows {
+		o.w.Write([]string{strconv.Itoa(r.Attempt), seconds(r.Delay), seconds(r.Min), seconds(r.Max), seconds(r.Elapsed)})
+	}
+	o.w.Flush()
+	return o.w.Error()
+}
+
+func (o *csvOutput) histogram(buckets []bucket) error {
+	o.w.Write([]string{"start", "arrivals", "rate"})
+	for _, b := range buckets {
+		o.w.Write([]string{seconds(b.Start), strconv.Itoa(b.Arrivals), strconv.FormatFloat(b.Rate, 'f', -1, 64)})
+	}
+	o.w.Flush()
+	return o.w.Error()
+}
+
+type jsonOutput struct{ w io.Writer }
+
+func (o *jsonOutput) encode(v any) error {
+	enc := json.NewEncoder(o.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func (o *jsonOutput) schedule(rows []row) error {
+	type jsonRow struct {
+		Attempt int     `json:"attempt"`
+		Delay   float64 `json:"delay"`
+		Min     float64 `json:"min"`
+		Max     float64 `json:"max"`
+		Elapsed float64 `json:"elapsed"`
+	}
+	v := make([]jsonRow, len(rows))
+	for i, r := range rows {
+		v[i] = jsonRow{r.Attempt, r.Delay.Seconds(), r.Min.Seconds(), r.Max.Seconds(), r.Elapsed.Seconds()}
+	}
+	return o.encode(v)
+}
+
+func (o *jsonOutput) histogram(buckets []bucket) error {
+	type jsonBucket struct {
+		Start    float64 `json:"start"`
+		Arrivals int     `json:"arrivals"`
+		Rate     float64 `json:"rate"`
+	}
+	v := make([]jsonBucket, len(buckets))
+	for i, b := range buckets {
+		v[i] = jsonBucket{b.Start.Seconds(), b.Arrivals, b.Rate}
+	}
+	return o.encode(v)
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestSchedule(t *testing.T) {
+	options, err := backoff.Parse("exp(initial=100ms,max=1s,mult=2,jitter=0.5,retries=5)")
+	require.NoError(t, err)
+	rows := schedule(options, 20)
+	require.Len(t, rows, 5)
+	assert.Equal(t, row{Attempt: 1, Delay: 100 * time.Millisecond, Min: 50 * time.Millisecond, Max: 150 * time.Millisecond, Elapsed: 100 * time.Millisecond}, rows[0])
+	assert.Equal(t, time.Second, rows[4].Delay)
+	assert.Equal(t, 2500*time.Millisecond, rows[4].Elapsed)
+
+	// MaxElapsedTime で停止する
+	options, err = backoff.Parse("const(1s,elapsed=3s)")
+	require.NoError(t, err)
+	assert.Len(t, schedule(options, 20), 3)
+}
+
+func TestSimulate(t *testing.T) {
+	options, err := backoff.Parse("const(1s,retries=2)")
+	require.NoError(t, err)
+	buckets, err := simulate(options, 10, 20, 0, time.Second)
+	require.NoError(t, err)
+	require.Len(t, buckets, 3)
+	for _, b := range buckets {
+		assert.Equal(t, 10, b.Arrivals)
+		assert.Equal(t, 10.0, b.Rate)
+	}
+
+	// バケットが多すぎる
+	options, err = backoff.Parse("exp(initial=1s,retries=20,elapsed=0s)")
+	require.NoError(t, err)
+	_, err = simulate(options, 10, 20, 0, time.Nanosecond)
+	assert.Error(t, err)
+}
+
+func TestRun(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	require.Equal(t, 0, run([]string{"-policy", "const(1s,retries=2)", "-format", "csv"}, &stdout, &stderr))
+	assert.Equal(t, "attempt,delay,min,max,elapsed\n1,1,1,1,1\n2,1,1,1,2\n", stdout.String())
+
+	stdout.Reset()
+	require.Equal(t, 0, run([]string{"-policy", "const(1s,retries=2)", "-clients", "3", "-format", "json"}, &stdout, &stderr))
+	var v []map[string]float64
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &v))
+	assert.Len(t, v, 3)
+
+	stdout.Reset()
+	require.Equal(t, 0, run(nil, &stdout, &stderr))
+	assert.True(t, strings.HasPrefix(strings.TrimSpace(stdout.String()), "attempt"))
+
+	assert.Equal(t, 2, run([]string{"-policy", "linear(1s)"}, &stdout, &stderr))
+	assert.Equal(t, 2, run([]string{"-format", "xml"}, &stdout, &stderr))
+	assert.Equal(t, 1, run([]string{"-policy", "const(1s,retries=2)", "-clients", "3", "-bucket", "1ns"}, &stdout, &stderr))
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	cenkalti "github.com/cenkalti/backoff/v4"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+// clock is [cenkalti.Clock] advanced manually.
+type clock struct{ now time.Time }
+
+func (c *clock) Now() time.Time { return c.now }
+
+// newBackOff returns the BackOff of options driven by clk, and its randomization factor.
+func newBackOff(options []backoff.Option, clk *clock, randomize bool) (cenkalti.BackOff, float64) {
+	exp := cenkalti.NewExponentialBackOff()
+	b := backoff.Apply(exp, options...)
+	jitter := exp.RandomizationFactor
+	if !randomize {
+		exp.RandomizationFactor = 0
+	}
+	exp.Clock = clk
+	b.Reset()
+	return b, jitter
+}
+
+// row is a row of the delay table.
+type row struct {
+	// Attempt is the number of the failed attempt after which Delay is slept.
+	Attempt int
+	Delay   time.Duration
+	Min     time.Duration
+	Max     time.Duration
+	// Elapsed is the cumulative Delay.
+	Elapsed time.Duration
+}
+
+// schedule returns the delay table of options without jitter, up to limit rows.
+func schedule(options []backoff.Option, limit int) []row {
+	clk := &clock{now: time.Unix(0, 0)}
+	b, jitter := newBackOff(options, clk, false)
+
+	var rows []row
+	var elapsed time.Duration
+	for i := 1; i <= limit; i++ {
+		d := b.NextBackOff()
+		if d == cenkalti.Stop {
+			break
+		}
+		elapsed += d
+		clk.now = clk.now.Add(d)
+		rows = append(rows, row{
+			Attempt: i,
+			Delay:   d,
+			Min:     time.Duration(float64(d) * (1 - jitter)),
+			Max:     time.Duration(float64(d) * (1 + jitter)),
+			Elapsed: elapsed,
+		})
+	}
+	return rows
+}
+
+// bucket is a bucket of the histogram of arrivals at the server.
+type bucket struct {
+	Start    time.Duration
+	Arrivals int
+	// Rate is Arrivals per second.
+	Rate float64
+}
+
+// maxBuckets is the maximum number of the buckets of the histogram.
+const maxBuckets = 100000
+
+// simulate returns the histogram of the attempts of clients failing every time,
+// each starting at a random time in [0, spread), and attempting at most limit+1 times.
+// It returns an error if the histogram needs more than maxBuckets buckets of width.
+func simulate(options []backoff.Option, clients, limit int, spread, width time.Duration) ([]bucket, error) {
+	var arrivals []time.Duration
+	for c := 0; c < clients; c++ {
+		var t time.Duration
+		if spread > 0 {
+			t = time.Duration(rand.Int63n(int64(spread)))
+		}
+		clk := &clock{now: time.Unix(0, 0).Add(t)}
+		b, _ := newBackOff(options, clk, true)
+
+		arrivals = append(arrivals, t)
+		for i := 0; i < limit; i++ {
+			d := b.NextBackOff()
+			if d == cenkalti.Stop {
+				break
+			}
+			t += d
+			clk.now = clk.now.Add(d)
+			arrivals = append(arrivals, t)
+		}
+	}
+
+	if len(arrivals) == 0 {
+		return nil, nil
+	}
+	var last time.Duration
+	for _, t := range arrivals {
+		last = max(last, t)
+	}
+	if last/width >= maxBuckets {
+		return nil, fmt.Errorf("backoff-sim: the span %v needs more than %d buckets of %v, increase -bucket", last, maxBuckets, width)
+	}
+
+	buckets := make([]bucket, last/width+1)
+	for i := range buckets {
+		buckets[i].Start = time.Duration(i) * width
+	}
+	for _, t := range arrivals {
+		buckets[t/width].Arrivals++
+	}
+	for i := range buckets {
+		buckets[i].Rate = float64(buckets[i].Arrivals) / width.Seconds()
+	}
+	return buckets, nil
+}
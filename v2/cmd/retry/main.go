@@ -0,0 +1,226 @@
+// Command retry runs a command under backoff until it exits successfully.
+//
+//	retry -initial 1s -max 30s -retries 5 -- curl -fsS http://localhost:8080/
+//
+// The command is retried when it exits with non-zero status.
+// The exit statuses to retry, or not to retry, can be restricted by -retry-on and -permanent.
+// The signals SIGINT, SIGTERM and SIGHUP are forwarded to the running command, and stop retrying.
+// The summary is printed to stderr, and retry exits with the exit status of the last attempt.
+// When stopped by a signal, retry exits with the exit status of the command the signal was forwarded to,
+// or 128 plus the signal number if the command was killed by the signal or was not running.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// exitCodes is [flag.Value] of comma separated exit codes.
+type exitCodes []int
+
+func (c *exitCodes) String() string {
+	s := make([]string, len(*c))
+	for i, code := range *c {
+		s[i] = strconv.Itoa(code)
+	}
+	return strings.Join(s, ",")
+}
+
+func (c *exitCodes) Set(s string) error {
+	*c = nil
+	for _, v := range strings.Split(s, ",") {
+		code, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return err
+		}
+		*c = append(*c, code)
+	}
+	return nil
+}
+
+func (c exitCodes) contains(code int) bool {
+	for _, v := range c {
+		if v == code {
+			return true
+		}
+	}
+	return false
+}
+
+// startError is the error of starting the command, which is not retried.
+type startError struct{ err error }
+
+func (e *startError) Error() string { return e.err.Error() }
+func (e *startError) Unwrap() error { return e.err }
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("retry", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: retry [flags] -- command [args...]")
+		fs.PrintDefaults()
+	}
+	policy := backoff.FlagVar(fs, "policy", nil, "retry policy spec, e.g. exp(initial=1s,max=30s,retries=5)")
+	initial := fs.Duration("initial", backoff.DefaultInitialInterval, "initial interval")
+	max := fs.Duration("max", backoff.DefaultMaxInterval, "max interval")
+	mult := fs.Float64("mult", backoff.DefaultMultiplier, "multiplier")
+	jitter := fs.Float64("jitter", backoff.DefaultRandomizationFactor, "randomization factor")
+	elapsed := fs.Duration("elapsed", backoff.DefaultMaxElapsedTime, "max elapsed time, 0 for no limit")
+	retries := fs.Uint64("retries", 0, "max retries, unlimited if not set")
+	var retryOn, permanent exitCodes
+	fs.Var(&retryOn, "retry-on", "comma separated exit statuses to retry (default all non-zero)")
+	fs.Var(&permanent, "permanent", "comma separated exit statuses not to retry")
+	quiet := fs.Bool("quiet", false, "do not print the summary")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	// The flags set explicitly override -policy.
+	options := append([]backoff.Option(nil), *policy...)
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "initial":
+			options = append(options, backoff.InitialInterval(*initial))
+		case "max":
+			options = append(options, backoff.MaxInterval(*max))
+		case "mult":
+			options = append(options, backoff.Multiplier(*mult))
+		case "jitter":
+			options = append(options, backoff.RandomizationFactor(*jitter))
+		case "elapsed":
+			options = append(options, backoff.MaxElapsedTime(*elapsed))
+		case "retries":
+			options = append(options, backoff.MaxRetries(*retries))
+		}
+	})
+	options = append(options, backoff.RetryIf(func(err error) bool {
+		var se *startError
+		if errors.As(err, &se) {
+			return false
+		}
+		var ee *exec.ExitError
+		if !errors.As(err, &ee) {
+			return true
+		}
+		code := ee.ExitCode()
+		if permanent.contains(code) {
+			return false
+		}
+		return len(retryOn) == 0 || retryOn.contains(code)
+	}))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	var cur *exec.Cmd
+	// sig is the signal received, and target is the command it was forwarded to.
+	var sig os.Signal
+	var target *exec.Cmd
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
+	defer func() {
+		signal.Stop(sigs)
+		close(sigs)
+	}()
+	go func() {
+		for s := range sigs {
+			mu.Lock()
+			sig, target = s, nil
+			if cur != nil && cur.Process != nil {
+				_ = cur.Process.Signal(s)
+				target = cur
+			}
+			mu.Unlock()
+			cancel()
+		}
+	}()
+
+	start := time.Now()
+	attempts := 0
+	err := backoff.RetryContext(
+		ctx,
+		func() error {
+			attempts++
+			cmd := exec.Command(fs.Arg(0), fs.Args()[1:]...)
+			cmd.Stdin, cmd.Stdout, cmd.Stderr = stdin, stdout, stderr
+
+			mu.Lock()
+			err := cmd.Start()
+			if err == nil {
+				cur = cmd
+			}
+			mu.Unlock()
+			if err != nil {
+				return &startError{err}
+			}
+
+			err = cmd.Wait()
+			mu.Lock()
+			cur = nil
+			mu.Unlock()
+			if err != nil && !*quiet {
+				fmt.Fprintf(stderr, "retry: attempt %d: %v\n", attempts, err)
+			}
+			return err
+		},
+		options...,
+	)
+	elapsedTime := time.Since(start).Round(time.Millisecond)
+
+	if err == nil {
+		if !*quiet {
+			fmt.Fprintf(stderr, "retry: succeeded on attempt %d in %v\n", attempts, elapsedTime)
+		}
+		return 0
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if sig != nil {
+		if !*quiet {
+			fmt.Fprintf(stderr, "retry: interrupted by %v after %d attempts in %v\n", sig, attempts, elapsedTime)
+		}
+		if target != nil && target.ProcessState != nil && target.ProcessState.ExitCode() >= 0 {
+			return target.ProcessState.ExitCode()
+		}
+		if s, ok := sig.(syscall.Signal); ok {
+			return 128 + int(s)
+		}
+		return 1
+	}
+
+	if !*quiet {
+		fmt.Fprintf(stderr, "retry: gave up after %d attempts in %v: %v\n", attempts, elapsedTime, err)
+	}
+	var ee *exec.ExitError
+	if errors.As(err, &ee) && ee.ExitCode() > 0 {
+		return ee.ExitCode()
+	}
+	var se *startError
+	if errors.As(err, &se) {
+		return 127
+	}
+	return 1
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not found")
+	}
+
+	flags := []string{"-initial", "1ms", "-max", "1ms"}
+
+	t.Run("success", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(context.Background(), append(flags, "--", "sh", "-c", "echo hello"), nil, &stdout, &stderr)
+		assert.Equal(t, 0, code)
+		assert.Equal(t, "hello\n", stdout.String())
+		assert.Contains(t, stderr.String(), "succeeded on attempt 1")
+	})
+
+	t.Run("retries", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(context.Background(), append(flags, "-retries", "2", "--", "sh", "-c", "echo x; exit 3"), nil, &stdout, &stderr)
+		assert.Equal(t, 3, code)
+		// 最初の1回と最大 retries 回
+		assert.Equal(t, 3, strings.Count(stdout.String(), "x"))
+		assert.Contains(t, stderr.String(), "gave up after 3 attempts")
+	})
+
+	t.Run("permanent", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(context.Background(), append(flags, "-retries", "2", "-permanent", "3", "--", "sh", "-c", "exit 3"), nil, &stdout, &stderr)
+		assert.Equal(t, 3, code)
+		assert.Contains(t, stderr.String(), "gave up after 1 attempts")
+	})
+
+	t.Run("retry-on", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(context.Background(), append(flags, "-retries", "2", "-retry-on", "4,5", "--", "sh", "-c", "exit 3"), nil, &stdout, &stderr)
+		assert.Equal(t, 3, code)
+		assert.Contains(t, stderr.String(), "gave up after 1 attempts")
+	})
+
+	t.Run("policy", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(context.Background(), []string{"-policy", "const(1ms,retries=1)", "--", "sh", "-c", "exit 1"}, nil, &stdout, &stderr)
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr.String(), "gave up after 2 attempts")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(context.Background(), append(flags, "--", "no-such-command-for-test"), nil, &stdout, &stderr)
+		assert.Equal(t, 127, code)
+	})
+
+	t.Run("usage", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		assert.Equal(t, 2, run(context.Background(), nil, nil, &stdout, &stderr))
+	})
+}
@@ -0,0 +1,57 @@
+//go:build unix
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunSignal(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not found")
+	}
+
+	flags := []string{"-initial", "1ms", "-max", "1ms"}
+
+	// signal は SIGTERM を実行中のコマンドに転送して、その終了ステータスで終了する.
+	signal := func(t *testing.T, script string) (int, string) {
+		r, w, err := os.Pipe()
+		require.NoError(t, err)
+		defer r.Close()
+
+		var stderr bytes.Buffer
+		done := make(chan int)
+		go func() {
+			defer w.Close()
+			done <- run(context.Background(), append(flags, "--", "sh", "-c", script), nil, w, &stderr)
+		}()
+
+		// コマンドの起動を待ってからシグナルを送る
+		line, err := bufio.NewReader(r).ReadString('\n')
+		require.NoError(t, err)
+		require.Equal(t, "ready\n", line)
+		require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGTERM))
+		return <-done, stderr.String()
+	}
+
+	t.Run("signal killed", func(t *testing.T) {
+		code, stderr := signal(t, "echo ready; exec sleep 10")
+		assert.Equal(t, 128+int(syscall.SIGTERM), code)
+		assert.Contains(t, stderr, "interrupted by terminated after 1 attempts")
+	})
+
+	t.Run("signal trapped", func(t *testing.T) {
+		code, stderr := signal(t, "trap 'exit 7' TERM; echo ready; while :; do sleep 0.01; done")
+		assert.Equal(t, 7, code)
+		assert.Contains(t, stderr, "interrupted by terminated after 1 attempts")
+	})
+}
@@ -0,0 +1,159 @@
+// Command waitfor waits until all targets become ready.
+//
+//	waitfor -timeout 1m tcp:db:5432 http://api:8080/healthz file:/run/app.ready unix:/run/app.sock
+//
+// The targets are
+//
+//   - tcp:host:port, ready when a TCP connection is established.
+//   - unix:path, ready when a connection to the unix socket is established.
+//   - http://... or https://..., ready when GET responds with -status, or 2xx if -status is 0.
+//   - file:path, ready when the file exists.
+//
+// The targets are polled by the policy of -policy, or the preset "polling" if not set,
+// until -timeout. The policy stops by elapsed time only if it sets elapsed, e.g. const(1s,elapsed=30s).
+// waitfor exits with 0 when all targets are ready, 1 otherwise.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/takumakei/go-backoff/v2"
+	"github.com/takumakei/go-backoff/v2/backoffnet"
+)
+
+func main() {
+	os.Exit(run(context.Background(), os.Args[1:], os.Stderr))
+}
+
+type config struct {
+	options        []backoff.Option
+	attemptTimeout time.Duration
+	status         int
+}
+
+func run(ctx context.Context, args []string, stderr io.Writer) int {
+	fs := flag.NewFlagSet("waitfor", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: waitfor [flags] target...")
+		fs.PrintDefaults()
+	}
+	policy := backoff.Named("polling")
+	fs.Func("policy", `retry policy spec (default the preset "polling")`, func(s string) (err error) {
+		policy, err = backoff.ParsePolicy(s)
+		return
+	})
+	timeout := fs.Duration("timeout", time.Minute, "overall timeout, 0 for no limit")
+	attemptTimeout := fs.Duration("attempt-timeout", 5*time.Second, "timeout of each attempt")
+	status := fs.Int("status", 0, "expected status code of http targets, 0 for any 2xx")
+	quiet := fs.Bool("quiet", false, "do not print progress")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	c := &config{
+		options:        options(policy),
+		attemptTimeout: *attemptTimeout,
+		status:         *status,
+	}
+
+	if *timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	for _, target := range fs.Args() {
+		if err := c.wait(ctx, target); err != nil {
+			fmt.Fprintf(stderr, "waitfor: %s: %v\n", target, err)
+			return 1
+		}
+		if !*quiet {
+			fmt.Fprintf(stderr, "waitfor: %s is ready (%v)\n", target, time.Since(start).Round(time.Millisecond))
+		}
+	}
+	return 0
+}
+
+// options returns the options of policy.
+// MaxElapsedTime is disabled unless policy sets it, so that waitfor is limited by -timeout
+// rather than the default MaxElapsedTime of 15 minutes.
+func options(policy *backoff.Policy) []backoff.Option {
+	options := policy.Options()
+	if policy.MaxElapsedTime == nil {
+		options = append(options, backoff.MaxElapsedTime(0))
+	}
+	return options
+}
+
+func (c *config) wait(ctx context.Context, target string) error {
+	switch {
+	case strings.HasPrefix(target, "tcp:"):
+		return c.dial(ctx, "tcp", strings.TrimPrefix(strings.TrimPrefix(target, "tcp:"), "//"))
+	case strings.HasPrefix(target, "unix:"):
+		return c.dial(ctx, "unix", strings.TrimPrefix(target, "unix:"))
+	case strings.HasPrefix(target, "http://"), strings.HasPrefix(target, "https://"):
+		return c.http(ctx, target)
+	case strings.HasPrefix(target, "file:"):
+		return c.file(ctx, strings.TrimPrefix(target, "file:"))
+	}
+	return errors.New("unknown target")
+}
+
+func (c *config) dial(ctx context.Context, network, addr string) error {
+	d := &backoffnet.Dialer{
+		Base: &net.Dialer{Timeout: c.attemptTimeout},
+		// Any error is retried, because the host may not be resolved
+		// or the socket may not be created until the peer is up.
+		Options: append(append([]backoff.Option(nil), c.options...), backoff.RetryIf(func(error) bool { return true })),
+	}
+	conn, err := d.DialContext(ctx, network, addr)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func (c *config) http(ctx context.Context, url string) error {
+	client := &http.Client{Timeout: c.attemptTimeout}
+	return backoff.Until(ctx, func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return false, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return false, nil
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		if c.status != 0 {
+			return resp.StatusCode == c.status, nil
+		}
+		return 200 <= resp.StatusCode && resp.StatusCode < 300, nil
+	}, c.options...)
+}
+
+func (c *config) file(ctx context.Context, path string) error {
+	return backoff.Until(ctx, func(context.Context) (bool, error) {
+		_, err := os.Stat(path)
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return err == nil, err
+	}, c.options...)
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cenkalti "github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+var flags = []string{"-policy", "const(10ms)", "-timeout", "5s", "-quiet"}
+
+func TestRun(t *testing.T) {
+	t.Run("tcp", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer ln.Close()
+
+		var stderr bytes.Buffer
+		assert.Equal(t, 0, run(context.Background(), append(flags, "tcp:"+ln.Addr().String()), &stderr), stderr.String())
+	})
+
+	t.Run("unix", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "test.sock")
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			ln, err := net.Listen("unix", path)
+			if err == nil {
+				t.Cleanup(func() { ln.Close() })
+			}
+		}()
+
+		var stderr bytes.Buffer
+		assert.Equal(t, 0, run(context.Background(), append(flags, "unix:"+path), &stderr), stderr.String())
+	})
+
+	t.Run("http", func(t *testing.T) {
+		var n int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&n, 1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer srv.Close()
+
+		var stderr bytes.Buffer
+		assert.Equal(t, 0, run(context.Background(), append(flags, "-status", "204", srv.URL), &stderr), stderr.String())
+		assert.Equal(t, int32(3), n)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ready")
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			os.WriteFile(path, nil, 0o644)
+		}()
+
+		var stderr bytes.Buffer
+		assert.Equal(t, 0, run(context.Background(), append(flags, "file:"+path), &stderr), stderr.String())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		var stderr bytes.Buffer
+		path := filepath.Join(t.TempDir(), "never")
+		assert.Equal(t, 1, run(context.Background(), []string{"-policy", "const(10ms)", "-timeout", "50ms", "file:" + path}, &stderr))
+		assert.Contains(t, stderr.String(), "deadline exceeded")
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		var stderr bytes.Buffer
+		assert.Equal(t, 1, run(context.Background(), append(flags, "ftp://example.com"), &stderr))
+		assert.Equal(t, 2, run(context.Background(), nil, &stderr))
+	})
+}
+
+func TestOptions(t *testing.T) {
+	elapsed := func(policy *backoff.Policy) time.Duration {
+		exp := cenkalti.NewExponentialBackOff()
+		backoff.Apply(exp, options(policy)...)
+		return exp.MaxElapsedTime
+	}
+
+	// elapsed を指定しないポリシーは -timeout まで待つ
+	assert.Equal(t, time.Duration(0), elapsed(backoff.Named("polling")))
+	p, err := backoff.ParsePolicy("exp(initial=10ms)")
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), elapsed(p))
+
+	// elapsed を指定したポリシーはそれに従う
+	p, err = backoff.ParsePolicy("const(10ms,elapsed=30s)")
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, elapsed(p))
+}
@@ -0,0 +1,78 @@
+package backoff
+
+import (
+	"fmt"
+	"time"
+)
+
+// Config is the retry policy loaded from configuration files such as JSON, YAML and TOML.
+//
+// The fields are nil unless they are specified.
+// Kind defaults to [KindExponential].
+// For [KindConstant], InitialInterval is the interval.
+//
+//	{"kind": "exp", "initial_interval": "250ms", "max_interval": "30s", "max_retries": 5}
+type Config struct {
+	Kind                Kind      `json:"kind,omitempty" yaml:"kind,omitempty" toml:"kind,omitempty"`
+	InitialInterval     *Duration `json:"initial_interval,omitempty" yaml:"initial_interval,omitempty" toml:"initial_interval,omitempty"`
+	RandomizationFactor *float64  `json:"randomization_factor,omitempty" yaml:"randomization_factor,omitempty" toml:"randomization_factor,omitempty"`
+	Multiplier          *float64  `json:"multiplier,omitempty" yaml:"multiplier,omitempty" toml:"multiplier,omitempty"`
+	MaxInterval         *Duration `json:"max_interval,omitempty" yaml:"max_interval,omitempty" toml:"max_interval,omitempty"`
+	MaxElapsedTime      *Duration `json:"max_elapsed_time,omitempty" yaml:"max_elapsed_time,omitempty" toml:"max_elapsed_time,omitempty"`
+	MaxRetries          *uint64   `json:"max_retries,omitempty" yaml:"max_retries,omitempty" toml:"max_retries,omitempty"`
+}
+
+// Policy returns the policy of c, or an error if it is invalid.
+func (c *Config) Policy() (*Policy, error) {
+	p := &Policy{
+		Kind:                c.Kind,
+		InitialInterval:     c.InitialInterval.duration(),
+		RandomizationFactor: c.RandomizationFactor,
+		Multiplier:          c.Multiplier,
+		MaxInterval:         c.MaxInterval.duration(),
+		MaxElapsedTime:      c.MaxElapsedTime.duration(),
+		MaxRetries:          c.MaxRetries,
+	}
+	if p.Kind == "" {
+		p.Kind = KindExponential
+	}
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("backoff: invalid config: %w", err)
+	}
+	return p, nil
+}
+
+// Options returns the options of c, or an error if it is invalid.
+func (c *Config) Options() ([]Option, error) {
+	p, err := c.Policy()
+	if err != nil {
+		return nil, err
+	}
+	return p.Options(), nil
+}
+
+// Duration is [time.Duration] represented as the string like "250ms" in configuration files.
+type Duration time.Duration
+
+// MarshalText implements [encoding.TextMarshaler].
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (d *Duration) UnmarshalText(text []byte) error {
+	v, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+func (d *Duration) duration() *time.Duration {
+	if d == nil {
+		return nil
+	}
+	v := time.Duration(*d)
+	return &v
+}
@@ -0,0 +1,74 @@
+package backoff_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	cenkalti "github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestConfig(t *testing.T) {
+	t.Run("exp", func(t *testing.T) {
+		var c backoff.Config
+		err := json.Unmarshal([]byte(`{
+			"initial_interval": "250ms",
+			"randomization_factor": 0.1,
+			"multiplier": 3,
+			"max_interval": "10s",
+			"max_elapsed_time": "1m",
+			"max_retries": 5
+		}`), &c)
+		require.NoError(t, err)
+
+		options, err := c.Options()
+		require.NoError(t, err)
+		exp := cenkalti.NewExponentialBackOff()
+		backoff.Apply(exp, options...)
+		assert.Equal(t, 250*time.Millisecond, exp.InitialInterval)
+		assert.Equal(t, 0.1, exp.RandomizationFactor)
+		assert.Equal(t, 3.0, exp.Multiplier)
+		assert.Equal(t, 10*time.Second, exp.MaxInterval)
+		assert.Equal(t, time.Minute, exp.MaxElapsedTime)
+
+		p, err := c.Policy()
+		require.NoError(t, err)
+		assert.Equal(t, "exp(initial=250ms,max=10s,mult=3,jitter=0.1,retries=5,elapsed=1m0s)", p.String())
+	})
+
+	t.Run("const", func(t *testing.T) {
+		var c backoff.Config
+		require.NoError(t, json.Unmarshal([]byte(`{"kind": "const", "initial_interval": "1s"}`), &c))
+		p, err := c.Policy()
+		require.NoError(t, err)
+		assert.Equal(t, "const(1s)", p.String())
+	})
+
+	t.Run("marshal", func(t *testing.T) {
+		d := backoff.Duration(1500 * time.Millisecond)
+		b, err := json.Marshal(backoff.Config{InitialInterval: &d})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"initial_interval": "1.5s"}`, string(b))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var c backoff.Config
+		assert.Error(t, json.Unmarshal([]byte(`{"initial_interval": "soon"}`), &c))
+
+		for _, s := range []string{
+			`{"kind": "linear"}`,
+			`{"kind": "const"}`,
+			`{"kind": "const", "initial_interval": "1s", "multiplier": 2}`,
+			`{"randomization_factor": 1.5}`,
+			`{"max_interval": "-1s"}`,
+		} {
+			var c backoff.Config
+			require.NoError(t, json.Unmarshal([]byte(s), &c))
+			_, err := c.Options()
+			assert.Error(t, err, s)
+		}
+	})
+}
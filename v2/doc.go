@@ -1,2 +1,4 @@
 // Package backoff provides wrapper functions for "github.com/cenkalti/backoff/v4".
 package backoff
+
+//go:generate go -C .. run ./internal/cmd/genretry -mod v2 -dir v2
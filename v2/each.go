@@ -0,0 +1,58 @@
+package backoff
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// RetryEach calls fn for each of inputs with retry, at most concurrency at a time.
+//
+// Each input is retried by its own BackOff created with options.
+// [WithBackOff] shares its BackOff by all the inputs, so use [WithBackOffFunc] instead.
+// Use [WithBudget] to limit the retries shared by all the inputs.
+// concurrency less than or equal to 0 means no limit.
+//
+// outs[i] and errs[i] are the results of fn for inputs[i].
+// errs is nil if all the inputs succeeded.
+// The inputs not started before ctx is done are not called, and their errors are ctx.Err().
+func RetryEach[In, Out any](ctx context.Context, inputs []In, fn func(context.Context, In) (Out, error), concurrency int, options ...Option) (outs []Out, errs []error) {
+	outs = make([]Out, len(inputs))
+	results := make([]error, len(inputs))
+	if concurrency <= 0 || concurrency > len(inputs) {
+		concurrency = len(inputs)
+	}
+
+	var next atomic.Int64
+	var failed atomic.Bool
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(inputs) {
+					return
+				}
+				if err := ctx.Err(); err != nil {
+					results[i] = err
+				} else {
+					results[i] = retry(ctx, func(ctx context.Context) (err error) {
+						outs[i], err = fn(ctx, inputs[i])
+						return
+					}, options)
+				}
+				if results[i] != nil {
+					failed.Store(true)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if failed.Load() {
+		errs = results
+	}
+	return
+}
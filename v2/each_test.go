@@ -0,0 +1,188 @@
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cenkalti "github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestRetryEach(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		inputs := make([]int, 100)
+		for i := range inputs {
+			inputs[i] = i
+		}
+
+		var running, peak atomic.Int32
+		var tries sync.Map
+		outs, errs := backoff.RetryEach(
+			context.Background(),
+			inputs,
+			func(ctx context.Context, in int) (string, error) {
+				n := running.Add(1)
+				defer running.Add(-1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+
+				// 偶数は1回失敗する
+				if _, loaded := tries.LoadOrStore(in, true); !loaded && in%2 == 0 {
+					return "", errors.New("temporary")
+				}
+				return strconv.Itoa(in), nil
+			},
+			8,
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+		)
+		assert.Nil(t, errs)
+		for i, out := range outs {
+			assert.Equal(t, strconv.Itoa(i), out)
+		}
+		assert.LessOrEqual(t, peak.Load(), int32(8))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		fatal := errors.New("fatal")
+		outs, errs := backoff.RetryEach(
+			context.Background(),
+			[]int{1, 2, 3},
+			func(ctx context.Context, in int) (int, error) {
+				if in == 2 {
+					return 0, cenkalti.Permanent(fatal)
+				}
+				return in * 10, nil
+			},
+			0,
+		)
+		assert.Equal(t, []int{10, 0, 30}, outs)
+		if assert.Len(t, errs, 3) {
+			assert.NoError(t, errs[0])
+			assert.ErrorIs(t, errs[1], fatal)
+			assert.NoError(t, errs[2])
+		}
+	})
+
+	t.Run("budget", func(t *testing.T) {
+		budget := backoff.NewBudget(5)
+		var calls atomic.Int32
+		_, errs := backoff.RetryEach(
+			context.Background(),
+			make([]int, 10),
+			func(ctx context.Context, in int) (int, error) {
+				calls.Add(1)
+				return 0, errors.New("never")
+			},
+			4,
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(3),
+			backoff.WithBudget(budget),
+		)
+		assert.Len(t, errs, 10)
+		// 各入力の1回目と、共有するリトライ5回
+		assert.Equal(t, int32(15), calls.Load())
+		assert.Zero(t, budget.Remaining())
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel() // キャンセル
+
+		called := false
+		_, errs := backoff.RetryEach(
+			ctx,
+			[]int{1, 2},
+			func(ctx context.Context, in int) (int, error) {
+				called = true
+				return in, nil
+			},
+			1,
+		)
+		assert.False(t, called)
+		assert.Equal(t, []error{context.Canceled, context.Canceled}, errs)
+	})
+
+	t.Run("WithBackOffFunc", func(t *testing.T) {
+		inputs := make([]int, 64)
+		for i := range inputs {
+			inputs[i] = i
+		}
+
+		// 入力ごとに BackOff を作るので、go test -race でも競合しない
+		var created atomic.Int32
+		var tries sync.Map
+		outs, errs := backoff.RetryEach(
+			context.Background(),
+			inputs,
+			func(ctx context.Context, in int) (int, error) {
+				if _, loaded := tries.LoadOrStore(in, true); !loaded {
+					return 0, errors.New("temporary")
+				}
+				return in, nil
+			},
+			8,
+			backoff.WithBackOffFunc(func() backoff.BackOff {
+				created.Add(1)
+				exp := cenkalti.NewExponentialBackOff()
+				exp.InitialInterval = time.Millisecond
+				exp.MaxInterval = time.Millisecond
+				return exp
+			}),
+		)
+		assert.Nil(t, errs)
+		assert.Equal(t, inputs, outs)
+		assert.Equal(t, int32(len(inputs)), created.Load())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		outs, errs := backoff.RetryEach(context.Background(), nil, func(context.Context, int) (int, error) { return 0, nil }, 4)
+		assert.Empty(t, outs)
+		assert.Nil(t, errs)
+	})
+}
+
+func TestWithBudget(t *testing.T) {
+	budget := backoff.NewBudget(2)
+	n := 0
+	never := errors.New("never")
+	err := backoff.Retry(
+		func() error {
+			n++
+			return never
+		},
+		backoff.InitialInterval(1),
+		backoff.MaxInterval(1),
+		backoff.MaxRetries(5),
+		backoff.WithBudget(budget),
+	)
+	assert.ErrorIs(t, err, never)
+	// 予算の2回だけリトライする
+	assert.Equal(t, 3, n)
+	assert.Zero(t, budget.Remaining())
+
+	// 予算が尽きるとリトライしない
+	n = 0
+	err = backoff.Retry(
+		func() error {
+			n++
+			return never
+		},
+		backoff.InitialInterval(1),
+		backoff.WithBudget(budget),
+	)
+	assert.ErrorIs(t, err, never)
+	assert.Equal(t, 1, n)
+}
@@ -0,0 +1,66 @@
+package backoff
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// FromEnv returns the options read from the environment variables below, which are the fields of [Config].
+//
+//   - PREFIX_KIND
+//   - PREFIX_INITIAL_INTERVAL
+//   - PREFIX_RANDOMIZATION_FACTOR
+//   - PREFIX_MULTIPLIER
+//   - PREFIX_MAX_INTERVAL
+//   - PREFIX_MAX_ELAPSED_TIME
+//   - PREFIX_MAX_RETRIES
+//
+// The variables not set are left as the default.
+func FromEnv(prefix string) ([]Option, error) {
+	if prefix != "" && !strings.HasSuffix(prefix, "_") {
+		prefix += "_"
+	}
+
+	var c Config
+	for _, v := range []struct {
+		name string
+		set  func(string) error
+	}{
+		{"KIND", func(s string) error { c.Kind = Kind(s); return nil }},
+		{"INITIAL_INTERVAL", envDuration(&c.InitialInterval)},
+		{"RANDOMIZATION_FACTOR", envFloat(&c.RandomizationFactor)},
+		{"MULTIPLIER", envFloat(&c.Multiplier)},
+		{"MAX_INTERVAL", envDuration(&c.MaxInterval)},
+		{"MAX_ELAPSED_TIME", envDuration(&c.MaxElapsedTime)},
+		{"MAX_RETRIES", func(s string) error {
+			n, err := strconv.ParseUint(s, 10, 64)
+			c.MaxRetries = &n
+			return err
+		}},
+	} {
+		name := prefix + v.name
+		if s, ok := os.LookupEnv(name); ok {
+			if err := v.set(s); err != nil {
+				return nil, fmt.Errorf("backoff: invalid %s: %w", name, err)
+			}
+		}
+	}
+	return c.Options()
+}
+
+func envDuration(dst **Duration) func(string) error {
+	return func(s string) error {
+		*dst = new(Duration)
+		return (*dst).UnmarshalText([]byte(s))
+	}
+}
+
+func envFloat(dst **float64) func(string) error {
+	return func(s string) error {
+		f, err := strconv.ParseFloat(s, 64)
+		*dst = &f
+		return err
+	}
+}
@@ -0,0 +1,41 @@
+package backoff_test
+
+import (
+	"testing"
+	"time"
+
+	cenkalti "github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestFromEnv(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("APP_INITIAL_INTERVAL", "250ms")
+		t.Setenv("APP_MULTIPLIER", "3")
+		t.Setenv("APP_MAX_RETRIES", "1")
+
+		options, err := backoff.FromEnv("APP")
+		require.NoError(t, err)
+		exp := cenkalti.NewExponentialBackOff()
+		b := backoff.Apply(exp, options...)
+		assert.Equal(t, 250*time.Millisecond, exp.InitialInterval)
+		assert.Equal(t, 3.0, exp.Multiplier)
+		b.Reset()
+		b.NextBackOff()
+		assert.Equal(t, cenkalti.Stop, b.NextBackOff())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		options, err := backoff.FromEnv("NO_SUCH_PREFIX")
+		require.NoError(t, err)
+		assert.Empty(t, options)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv("APP_MAX_RETRIES", "many")
+		_, err := backoff.FromEnv("APP_")
+		assert.ErrorContains(t, err, "APP_MAX_RETRIES")
+	})
+}
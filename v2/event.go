@@ -0,0 +1,109 @@
+package backoff
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// EventKind is the kind of [Event].
+type EventKind int
+
+// Kinds of Event.
+const (
+	// EventStart is sent before the first attempt.
+	EventStart EventKind = iota
+
+	// EventAttemptFailed is sent when an attempt returns an error.
+	EventAttemptFailed
+
+	// EventSleeping is sent before sleeping for the next attempt.
+	EventSleeping
+
+	// EventSucceeded is sent when an attempt succeeds.
+	EventSucceeded
+
+	// EventGaveUp is sent when the retry operation ends with an error.
+	EventGaveUp
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case EventStart:
+		return "Start"
+	case EventAttemptFailed:
+		return "AttemptFailed"
+	case EventSleeping:
+		return "Sleeping"
+	case EventSucceeded:
+		return "Succeeded"
+	case EventGaveUp:
+		return "GaveUp"
+	}
+	return "EventKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Event is an event of the lifecycle of a retry operation sent by [Events].
+type Event struct {
+	Kind EventKind
+	Time time.Time
+
+	// Operation is the name given by [Operation].
+	Operation string
+
+	// Attempt is the number of the attempts so far.
+	Attempt int
+
+	// Err is the error of EventAttemptFailed and EventGaveUp.
+	Err error
+
+	// Delay is the duration of EventSleeping.
+	Delay time.Duration
+}
+
+// Events sends the events of the retry operation to ch.
+//
+// Sending never blocks. The events are dropped if ch is not ready.
+// Use [EventsWithDropped] to count them.
+func Events(ch chan<- Event) Option {
+	return EventsWithDropped(ch, nil)
+}
+
+// EventsWithDropped is [Events] counting the events dropped because ch is not ready by dropped.
+//
+// dropped may be shared by the options sending to the same ch. If dropped is nil, the events are not counted.
+func EventsWithDropped(ch chan<- Event, dropped *atomic.Uint64) Option {
+	return func(bu *builder) {
+		send := func(s *state, kind EventKind, err error, delay time.Duration) {
+			e := Event{
+				Kind:      kind,
+				Time:      time.Now(),
+				Operation: s.name,
+				Attempt:   s.attempts,
+				Err:       err,
+				Delay:     delay,
+			}
+			select {
+			case ch <- e:
+			default:
+				if dropped != nil {
+					dropped.Add(1)
+				}
+			}
+		}
+		bu.onStart = append(bu.onStart, func(s *state) { send(s, EventStart, nil, 0) })
+		bu.onResult = append(bu.onResult, func(s *state) {
+			if s.err != nil {
+				send(s, EventAttemptFailed, s.err, 0)
+			}
+		})
+		bu.onRetry = append(bu.onRetry, func(s *state) { send(s, EventSleeping, nil, s.next) })
+		bu.onDone = append(bu.onDone, func(s *state) {
+			if s.err != nil {
+				send(s, EventGaveUp, s.err, 0)
+			} else {
+				send(s, EventSucceeded, nil, 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func kinds(t *testing.T, ch chan backoff.Event) []backoff.EventKind {
+	close(ch)
+	var ks []backoff.EventKind
+	for e := range ch {
+		assert.False(t, e.Time.IsZero())
+		ks = append(ks, e.Kind)
+	}
+	return ks
+}
+
+func TestEvents(t *testing.T) {
+	t.Run("succeeded", func(t *testing.T) {
+		ch := make(chan backoff.Event, 16)
+		n := 0
+		r, err := backoff.RetryR2(
+			func() (int, error) {
+				n++
+				if n < 2 {
+					return 0, errors.New("temporary")
+				}
+				return 42, nil
+			},
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.Events(ch),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, 42, r)
+		assert.Equal(t, []backoff.EventKind{
+			backoff.EventStart,
+			backoff.EventAttemptFailed,
+			backoff.EventSleeping,
+			backoff.EventSucceeded,
+		}, kinds(t, ch))
+	})
+
+	t.Run("gave up", func(t *testing.T) {
+		ch := make(chan backoff.Event, 16)
+		never := errors.New("never")
+		_, _, err := backoff.RetryContextR3(
+			context.Background(),
+			func() (string, int, error) { return "", 0, never },
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(1),
+			backoff.Events(ch),
+			backoff.Operation("op"),
+		)
+		assert.ErrorIs(t, err, never)
+
+		close(ch)
+		var events []backoff.Event
+		for e := range ch {
+			events = append(events, e)
+		}
+		var ks []backoff.EventKind
+		for _, e := range events {
+			ks = append(ks, e.Kind)
+			assert.Equal(t, "op", e.Operation)
+		}
+		assert.Equal(t, []backoff.EventKind{
+			backoff.EventStart,
+			backoff.EventAttemptFailed,
+			backoff.EventSleeping,
+			backoff.EventAttemptFailed,
+			backoff.EventGaveUp,
+		}, ks)
+		assert.Equal(t, 2, events[4].Attempt)
+		assert.ErrorIs(t, events[4].Err, never)
+	})
+
+	t.Run("dropped", func(t *testing.T) {
+		ch := make(chan backoff.Event, 1)
+		var dropped atomic.Uint64
+		assert.NoError(t, backoff.Retry(func() error { return nil }, backoff.EventsWithDropped(ch, &dropped)))
+		assert.Equal(t, []backoff.EventKind{backoff.EventStart}, kinds(t, ch))
+		// 購読ごとに数える
+		assert.Equal(t, uint64(1), dropped.Load())
+
+		ch = make(chan backoff.Event, 1)
+		assert.NoError(t, backoff.Retry(func() error { return nil }, backoff.Events(ch)))
+		assert.Equal(t, []backoff.EventKind{backoff.EventStart}, kinds(t, ch))
+		assert.Equal(t, uint64(1), dropped.Load())
+	})
+}
+
+func TestEventKind(t *testing.T) {
+	assert.Equal(t, "GaveUp", backoff.EventGaveUp.String())
+	assert.Equal(t, "EventKind(42)", backoff.EventKind(42).String())
+}
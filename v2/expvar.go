@@ -0,0 +1,122 @@
+package backoff
+
+import (
+	"expvar"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultDurationBuckets is the upper bounds of the buckets of the histogram of durations used by [NewExpvarMetrics].
+var DefaultDurationBuckets = []time.Duration{
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	5 * time.Second,
+	10 * time.Second,
+	30 * time.Second,
+	time.Minute,
+	5 * time.Minute,
+	15 * time.Minute,
+}
+
+// ExpvarMetrics is [Metrics] published by expvar.
+//
+// The metrics are published as the map below for each operation.
+//
+//	{
+//	  "<operation>": {
+//	    "attempts": 0,
+//	    "retries": 0,
+//	    "give_ups": 0,
+//	    "duration": {"count": 0, "sum": 0.0, "le_0.01": 0, ..., "le_+Inf": 0}
+//	  }
+//	}
+//
+// The sum and the upper bounds of the buckets of duration are in seconds.
+// The buckets are cumulative.
+type ExpvarMetrics struct {
+	root    *expvar.Map
+	buckets []time.Duration
+
+	mu  sync.Mutex
+	ops map[string]*expvarOp
+}
+
+type expvarOp struct {
+	attempts, retries, giveUps expvar.Int
+	count                      expvar.Int
+	sum                        expvar.Float
+	buckets                    []*expvar.Int
+}
+
+var _ Metrics = (*ExpvarMetrics)(nil)
+
+// NewExpvarMetrics creates [ExpvarMetrics] published by name, with [DefaultDurationBuckets].
+//
+// NewExpvarMetrics panics if name is already published, as [expvar.Publish].
+func NewExpvarMetrics(name string) *ExpvarMetrics {
+	return &ExpvarMetrics{
+		root:    expvar.NewMap(name),
+		buckets: DefaultDurationBuckets,
+		ops:     make(map[string]*expvarOp),
+	}
+}
+
+func (m *ExpvarMetrics) op(name string) *expvarOp {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if op, ok := m.ops[name]; ok {
+		return op
+	}
+
+	op := &expvarOp{buckets: make([]*expvar.Int, len(m.buckets)+1)}
+	duration := new(expvar.Map).Init()
+	duration.Set("count", &op.count)
+	duration.Set("sum", &op.sum)
+	for i := range op.buckets {
+		op.buckets[i] = new(expvar.Int)
+		le := "+Inf"
+		if i < len(m.buckets) {
+			le = strconv.FormatFloat(m.buckets[i].Seconds(), 'g', -1, 64)
+		}
+		duration.Set("le_"+le, op.buckets[i])
+	}
+
+	vars := new(expvar.Map).Init()
+	vars.Set("attempts", &op.attempts)
+	vars.Set("retries", &op.retries)
+	vars.Set("give_ups", &op.giveUps)
+	vars.Set("duration", duration)
+	m.root.Set(name, vars)
+	m.ops[name] = op
+	return op
+}
+
+// Attempt implements [Metrics].
+func (m *ExpvarMetrics) Attempt(op string) {
+	m.op(op).attempts.Add(1)
+}
+
+// Retry implements [Metrics].
+func (m *ExpvarMetrics) Retry(op string) {
+	m.op(op).retries.Add(1)
+}
+
+// GiveUp implements [Metrics].
+func (m *ExpvarMetrics) GiveUp(op string) {
+	m.op(op).giveUps.Add(1)
+}
+
+// Duration implements [Metrics].
+func (m *ExpvarMetrics) Duration(op string, d time.Duration) {
+	o := m.op(op)
+	o.count.Add(1)
+	o.sum.Add(d.Seconds())
+	for i, b := range o.buckets {
+		if i == len(m.buckets) || d <= m.buckets[i] {
+			b.Add(1)
+		}
+	}
+}
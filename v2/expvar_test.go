@@ -0,0 +1,46 @@
+package backoff_test
+
+import (
+	"encoding/json"
+	"errors"
+	"expvar"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestExpvarMetrics(t *testing.T) {
+	m := backoff.NewExpvarMetrics("test_backoff")
+
+	never := errors.New("never")
+	err := backoff.Retry(
+		func() error { return never },
+		backoff.InitialInterval(1),
+		backoff.MaxInterval(1),
+		backoff.MaxRetries(2),
+		backoff.WithMetrics(m),
+		backoff.Operation("connect"),
+	)
+	assert.ErrorIs(t, err, never)
+	m.Duration("connect", 2*time.Minute)
+
+	var v map[string]struct {
+		Attempts int                `json:"attempts"`
+		Retries  int                `json:"retries"`
+		GiveUps  int                `json:"give_ups"`
+		Duration map[string]float64 `json:"duration"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(expvar.Get("test_backoff").String()), &v))
+	op := v["connect"]
+	assert.Equal(t, 3, op.Attempts)
+	assert.Equal(t, 2, op.Retries)
+	assert.Equal(t, 1, op.GiveUps)
+	assert.Equal(t, 2.0, op.Duration["count"])
+	assert.Equal(t, 1.0, op.Duration["le_0.01"])
+	assert.Equal(t, 1.0, op.Duration["le_60"])
+	assert.Equal(t, 2.0, op.Duration["le_300"])
+	assert.Equal(t, 2.0, op.Duration["le_+Inf"])
+}
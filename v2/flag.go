@@ -0,0 +1,46 @@
+package backoff
+
+import (
+	"flag"
+)
+
+// FlagVar defines a flag with name, default value def and usage string in fs,
+// whose value is a policy spec parsed by [ParsePolicy].
+// The return value is the address of the options of the policy.
+//
+//	options := backoff.FlagVar(flag.CommandLine, "retry", nil, "retry policy")
+//	flag.Parse()
+//	err := backoff.Retry(fn, *options...)
+func FlagVar(fs *flag.FlagSet, name string, def []Option, usage string) *[]Option {
+	v := &policyValue{options: def}
+	fs.Var(v, name, usage)
+	return &v.options
+}
+
+// policyValue is [flag.Value] of a policy spec.
+type policyValue struct {
+	options []Option
+	policy  *Policy
+}
+
+var _ flag.Getter = (*policyValue)(nil)
+
+func (v *policyValue) String() string {
+	if v == nil || v.policy == nil {
+		return ""
+	}
+	return v.policy.String()
+}
+
+func (v *policyValue) Set(s string) error {
+	p, err := ParsePolicy(s)
+	if err != nil {
+		return err
+	}
+	v.options, v.policy = p.Options(), p
+	return nil
+}
+
+func (v *policyValue) Get() any {
+	return v.options
+}
@@ -0,0 +1,41 @@
+package backoff_test
+
+import (
+	"flag"
+	"io"
+	"testing"
+	"time"
+
+	cenkalti "github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestFlagVar(t *testing.T) {
+	t.Run("set", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		options := backoff.FlagVar(fs, "retry", nil, "retry policy")
+		require.NoError(t, fs.Parse([]string{"-retry", "exp(initial=1s, retries=3)"}))
+		assert.Equal(t, "exp(initial=1s,retries=3)", fs.Lookup("retry").Value.String())
+
+		exp := cenkalti.NewExponentialBackOff()
+		backoff.Apply(exp, *options...)
+		assert.Equal(t, time.Second, exp.InitialInterval)
+	})
+
+	t.Run("default", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		def := []backoff.Option{backoff.MaxRetries(3)}
+		options := backoff.FlagVar(fs, "retry", def, "retry policy")
+		require.NoError(t, fs.Parse(nil))
+		assert.Len(t, *options, 1)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		backoff.FlagVar(fs, "retry", nil, "retry policy")
+		assert.Error(t, fs.Parse([]string{"-retry", "exp(1s)"}))
+	})
+}
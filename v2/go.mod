@@ -1,6 +1,6 @@
 module github.com/takumakei/go-backoff/v2
 
-go 1.18
+go 1.23
 
 require (
 	github.com/cenkalti/backoff/v4 v4.1.3
@@ -0,0 +1,71 @@
+package backoff
+
+import (
+	"log/slog"
+)
+
+// LogLevels is the levels of the records logged by [LoggerWithLevels].
+type LogLevels struct {
+	// Retry is the level of the record of each failed attempt to be retried.
+	Retry slog.Level
+
+	// GiveUp is the level of the record of giving up.
+	GiveUp slog.Level
+
+	// Success is the level of the record of success after retries.
+	Success slog.Level
+}
+
+// DefaultLogLevels is the levels used by [Logger].
+var DefaultLogLevels = LogLevels{
+	Retry:   slog.LevelWarn,
+	GiveUp:  slog.LevelError,
+	Success: slog.LevelInfo,
+}
+
+// Logger logs to l with [DefaultLogLevels].
+//
+// see: [LoggerWithLevels]
+func Logger(l *slog.Logger) Option {
+	return LoggerWithLevels(l, DefaultLogLevels)
+}
+
+// LoggerWithLevels logs a record per failed attempt to be retried, with attempt, error, delay and elapsed,
+// and a final record on giving up, or on success after retries, with attempts, error and elapsed.
+//
+// Success on the first attempt is not logged.
+// The name given by [Operation] is logged as operation if any.
+func LoggerWithLevels(l *slog.Logger, levels LogLevels) Option {
+	return func(bu *builder) {
+		bu.onRetry = append(bu.onRetry, func(s *state) {
+			logAttrs(l, s, levels.Retry, "backoff: retrying",
+				slog.Int("attempt", s.attempts),
+				slog.Any("error", s.err),
+				slog.Duration("delay", s.next),
+				slog.Duration("elapsed", s.elapsed()),
+			)
+		})
+		bu.onDone = append(bu.onDone, func(s *state) {
+			switch {
+			case s.err != nil:
+				logAttrs(l, s, levels.GiveUp, "backoff: gave up",
+					slog.Int("attempts", s.attempts),
+					slog.Any("error", s.err),
+					slog.Duration("elapsed", s.elapsed()),
+				)
+			case s.attempts > 1:
+				logAttrs(l, s, levels.Success, "backoff: succeeded",
+					slog.Int("attempts", s.attempts),
+					slog.Duration("elapsed", s.elapsed()),
+				)
+			}
+		})
+	}
+}
+
+func logAttrs(l *slog.Logger, s *state, level slog.Level, msg string, attrs ...slog.Attr) {
+	if s.name != "" {
+		attrs = append([]slog.Attr{slog.String("operation", s.name)}, attrs...)
+	}
+	l.LogAttrs(s.ctx, level, msg, attrs...)
+}
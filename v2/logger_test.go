@@ -0,0 +1,95 @@
+package backoff_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func records(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	var rs []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var r map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &r))
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+func TestLogger(t *testing.T) {
+	t.Run("give up", func(t *testing.T) {
+		var buf bytes.Buffer
+		l := slog.New(slog.NewJSONHandler(&buf, nil))
+
+		never := errors.New("never")
+		err := backoff.Retry(
+			func() error { return never },
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(2),
+			backoff.Logger(l),
+		)
+		assert.ErrorIs(t, err, never)
+
+		rs := records(t, &buf)
+		require.Len(t, rs, 3)
+		assert.Equal(t, "WARN", rs[0]["level"])
+		assert.Equal(t, "backoff: retrying", rs[0]["msg"])
+		assert.Equal(t, 1.0, rs[0]["attempt"])
+		assert.Equal(t, "never", rs[0]["error"])
+		assert.Contains(t, rs[0], "delay")
+		assert.Contains(t, rs[0], "elapsed")
+		assert.Equal(t, 2.0, rs[1]["attempt"])
+		assert.Equal(t, "ERROR", rs[2]["level"])
+		assert.Equal(t, "backoff: gave up", rs[2]["msg"])
+		assert.Equal(t, 3.0, rs[2]["attempts"])
+	})
+
+	t.Run("success", func(t *testing.T) {
+		var buf bytes.Buffer
+		l := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+		n := 0
+		err := backoff.Retry(
+			func() error {
+				n++
+				if n < 2 {
+					return errors.New("temporary")
+				}
+				return nil
+			},
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.LoggerWithLevels(l, backoff.LogLevels{
+				Retry:   slog.LevelDebug,
+				GiveUp:  slog.LevelWarn,
+				Success: slog.LevelDebug,
+			}),
+		)
+		assert.NoError(t, err)
+
+		rs := records(t, &buf)
+		require.Len(t, rs, 2)
+		assert.Equal(t, "DEBUG", rs[0]["level"])
+		assert.Equal(t, "backoff: succeeded", rs[1]["msg"])
+		assert.Equal(t, 2.0, rs[1]["attempts"])
+	})
+
+	t.Run("first try", func(t *testing.T) {
+		var buf bytes.Buffer
+		l := slog.New(slog.NewJSONHandler(&buf, nil))
+		assert.NoError(t, backoff.Retry(func() error { return nil }, backoff.Logger(l)))
+		// 1回目で成功した場合は記録しない
+		assert.Empty(t, buf.String())
+	})
+}
@@ -0,0 +1,102 @@
+package backoff
+
+import (
+	"sync"
+	"time"
+)
+
+// Metrics is the interface to record the metrics of retry operations.
+//
+// op is the name given by [Operation], or empty.
+type Metrics interface {
+	// Attempt is called before each attempt.
+	Attempt(op string)
+
+	// Retry is called for each failed attempt to be retried.
+	Retry(op string)
+
+	// GiveUp is called when the retry operation ends with an error.
+	GiveUp(op string)
+
+	// Duration is called with the total duration when the retry operation ends.
+	Duration(op string, d time.Duration)
+}
+
+// WithMetrics records the metrics of the retry operation to m.
+func WithMetrics(m Metrics) Option {
+	return func(bu *builder) {
+		bu.onAttempt = append(bu.onAttempt, func(s *state) { m.Attempt(s.name) })
+		bu.onRetry = append(bu.onRetry, func(s *state) { m.Retry(s.name) })
+		bu.onDone = append(bu.onDone, func(s *state) {
+			if s.err != nil {
+				m.GiveUp(s.name)
+			}
+			m.Duration(s.name, s.elapsed())
+		})
+	}
+}
+
+// MetricsSnapshot is the metrics of an operation recorded by [MemoryMetrics].
+type MetricsSnapshot struct {
+	Attempts  int
+	Retries   int
+	GiveUps   int
+	Durations []time.Duration
+}
+
+// MemoryMetrics is [Metrics] recording in memory, mainly for tests.
+//
+// The zero value is ready to use.
+type MemoryMetrics struct {
+	mu  sync.Mutex
+	ops map[string]*MetricsSnapshot
+}
+
+var _ Metrics = (*MemoryMetrics)(nil)
+
+func (m *MemoryMetrics) update(op string, fn func(*MetricsSnapshot)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ops == nil {
+		m.ops = make(map[string]*MetricsSnapshot)
+	}
+	s, ok := m.ops[op]
+	if !ok {
+		s = &MetricsSnapshot{}
+		m.ops[op] = s
+	}
+	fn(s)
+}
+
+// Attempt implements [Metrics].
+func (m *MemoryMetrics) Attempt(op string) {
+	m.update(op, func(s *MetricsSnapshot) { s.Attempts++ })
+}
+
+// Retry implements [Metrics].
+func (m *MemoryMetrics) Retry(op string) {
+	m.update(op, func(s *MetricsSnapshot) { s.Retries++ })
+}
+
+// GiveUp implements [Metrics].
+func (m *MemoryMetrics) GiveUp(op string) {
+	m.update(op, func(s *MetricsSnapshot) { s.GiveUps++ })
+}
+
+// Duration implements [Metrics].
+func (m *MemoryMetrics) Duration(op string, d time.Duration) {
+	m.update(op, func(s *MetricsSnapshot) { s.Durations = append(s.Durations, d) })
+}
+
+// Snapshot returns a copy of the metrics of op.
+func (m *MemoryMetrics) Snapshot(op string) MetricsSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.ops[op]
+	if !ok {
+		return MetricsSnapshot{}
+	}
+	c := *s
+	c.Durations = append([]time.Duration(nil), s.Durations...)
+	return c
+}
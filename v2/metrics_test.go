@@ -0,0 +1,53 @@
+package backoff_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestWithMetrics(t *testing.T) {
+	var m backoff.MemoryMetrics
+
+	never := errors.New("never")
+	err := backoff.Retry(
+		func() error { return never },
+		backoff.InitialInterval(1),
+		backoff.MaxInterval(1),
+		backoff.MaxRetries(2),
+		backoff.WithMetrics(&m),
+		backoff.Operation("fail"),
+	)
+	assert.ErrorIs(t, err, never)
+
+	n := 0
+	err = backoff.Retry(
+		func() error {
+			n++
+			if n < 2 {
+				return never
+			}
+			return nil
+		},
+		backoff.InitialInterval(1),
+		backoff.MaxInterval(1),
+		backoff.WithMetrics(&m),
+	)
+	assert.NoError(t, err)
+
+	s := m.Snapshot("fail")
+	assert.Equal(t, 3, s.Attempts)
+	assert.Equal(t, 2, s.Retries)
+	assert.Equal(t, 1, s.GiveUps)
+	assert.Len(t, s.Durations, 1)
+
+	s = m.Snapshot("")
+	assert.Equal(t, 2, s.Attempts)
+	assert.Equal(t, 1, s.Retries)
+	assert.Equal(t, 0, s.GiveUps)
+	assert.Len(t, s.Durations, 1)
+
+	assert.Equal(t, backoff.MetricsSnapshot{}, m.Snapshot("no-such-operation"))
+}
@@ -6,12 +6,12 @@ import (
 	"github.com/cenkalti/backoff/v4"
 )
 
-// New creates an [backoff.ExponentialBackOff] by calling [backoff.NewExponentialBackOff], applies options to it, returns it as [backoff.BackOff].
-func New(options ...Option) backoff.BackOff {
+// New creates an [backoff.ExponentialBackOff] by calling [backoff.NewExponentialBackOff], applies options to it, returns it as [BackOff].
+func New(options ...Option) BackOff {
 	return Apply(backoff.NewExponentialBackOff(), options...)
 }
 
-// NewContext creates an [backoff.ExponentialBackOff] by calling [backoff.NewExponentialBackOff], applies options to it, wraps by [backoff.WithContext] with ctx, returns it as [backoff.BackOff].
-func NewContext(ctx context.Context, options ...Option) backoff.BackOff {
-	return backoff.WithContext(New(options...), ctx)
+// NewContext creates an [backoff.ExponentialBackOff] by calling [backoff.NewExponentialBackOff], applies options to it, wraps by [backoff.WithContext] with ctx, returns it as [BackOff].
+func NewContext(ctx context.Context, options ...Option) BackOff {
+	return backoff.WithContext(ToCenkalti(New(options...)), ctx)
 }
@@ -0,0 +1,199 @@
+package backoff
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+// state is the state of a retry operation passed to the hooks of builder.
+type state struct {
+	ctx   context.Context
+	name  string
+	start time.Time
+
+	// actx is the context of the current attempt passed to fn.
+	actx context.Context
+
+	// attempts is the number of the attempts so far.
+	attempts int
+
+	// err is the error of the last attempt.
+	err error
+
+	// next is the delay before the next attempt, valid in onRetry.
+	next time.Duration
+}
+
+func (s *state) elapsed() time.Duration {
+	return time.Since(s.start)
+}
+
+// builders is the pool of the builders used by retry.
+var builders = sync.Pool{New: func() any { return new(builder) }}
+
+// defaultExp is the initial value of the ExponentialBackOff of the builder from the pool.
+var defaultExp = *backoff.NewExponentialBackOff()
+
+// begin returns the builder from the pool applied options, with BackOff reset before the first attempt.
+// The builder must be released by [builder.release].
+func begin(options []Option) *builder {
+	bu := builders.Get().(*builder)
+	bu.expv = defaultExp
+	bu.exp = &bu.expv
+	for _, opt := range options {
+		opt(bu)
+	}
+	bu.backOff().Reset()
+	return bu
+}
+
+// release puts bu back to the pool.
+func (bu *builder) release() {
+	clear(bu.onStart)
+	clear(bu.onAttempt)
+	clear(bu.onResult)
+	clear(bu.onRetry)
+	clear(bu.onDone)
+	*bu = builder{
+		onStart:   bu.onStart[:0],
+		onAttempt: bu.onAttempt[:0],
+		onResult:  bu.onResult[:0],
+		onRetry:   bu.onRetry[:0],
+		onDone:    bu.onDone[:0],
+	}
+	builders.Put(bu)
+}
+
+// retry calls fn until it does not return error, BackOff created by options stops, or ctx is done.
+// It behaves as [backoff.RetryNotify] with the BackOff wrapped by [backoff.WithContext] with ctx.
+//
+// fn is called with the context of each attempt, which is derived from ctx by the hooks.
+//
+// Nothing is allocated by retry until the first attempt fails, unless any hook is set.
+// fn must not escape, so that the closure passed by the caller is not allocated.
+func retry(ctx context.Context, fn func(context.Context) error, options []Option) error {
+	bu := begin(options)
+	defer bu.release()
+	if bu.err != nil {
+		return bu.err
+	}
+
+	var err error
+	if !bu.hooked() {
+		if err = fn(ctx); err == nil {
+			return nil
+		}
+	}
+	return bu.run(ctx, fn, err)
+}
+
+// run calls fn until it does not return error, BackOff stops, or ctx is done.
+// first is the error of the first attempt called by the caller without the hooks, or nil to call it by run.
+func (bu *builder) run(ctx context.Context, fn func(context.Context) error, first error) error {
+	s := &state{ctx: ctx, name: bu.name, start: time.Now()}
+	b := bu.backOff()
+	var retries uint64
+	var timer *time.Timer
+	defer func() {
+		if timer != nil {
+			stopTimer(timer)
+		}
+	}()
+
+	err := first
+	if err == nil {
+		for _, h := range bu.onStart {
+			h(s)
+		}
+		err = bu.attempt(fn, s)
+	} else {
+		s.attempts, s.err = 1, err
+	}
+
+	for err != nil {
+		var after time.Duration
+		var stop bool
+		if err, after, stop = bu.classify(err); stop {
+			break
+		}
+
+		next := backoff.Stop
+		if ctx.Err() == nil && (!bu.hasMax || retries < bu.max) {
+			retries++
+			next = b.NextBackOff()
+			if next != backoff.Stop && after > next {
+				next = after
+			}
+			if next != backoff.Stop && bu.budget != nil && !bu.budget.take() {
+				next = backoff.Stop
+			}
+		}
+		if next == backoff.Stop {
+			if cerr := ctx.Err(); cerr != nil {
+				err = cerr
+			}
+			break
+		}
+
+		s.next = next
+		for _, h := range bu.onRetry {
+			h(s)
+		}
+
+		if timer == nil {
+			timer = startTimer(next)
+		} else {
+			timer.Reset(next)
+		}
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-timer.C:
+			err = bu.attempt(fn, s)
+			continue
+		}
+		break
+	}
+
+	s.err = err
+	for _, h := range bu.onDone {
+		h(s)
+	}
+	return err
+}
+
+// attempt calls fn once, recording the attempt to s.
+func (bu *builder) attempt(fn func(context.Context) error, s *state) error {
+	s.attempts++
+	s.actx = s.ctx
+	for _, h := range bu.onAttempt {
+		h(s)
+	}
+	err := fn(s.actx)
+	s.err = err
+	for _, h := range bu.onResult {
+		h(s)
+	}
+	return err
+}
+
+// classify returns the error to return and true if err is not retried,
+// and the delay hinted by err otherwise.
+func (bu *builder) classify(err error) (_ error, after time.Duration, stop bool) {
+	var permanent *backoff.PermanentError
+	if errors.As(err, &permanent) {
+		return permanent.Err, 0, true
+	}
+	if bu.retryIf != nil && !bu.retryIf(err) {
+		return err, 0, true
+	}
+	var ra interface{ RetryAfter() time.Duration }
+	if errors.As(err, &ra) {
+		after = ra.RetryAfter()
+	}
+	return err, after, false
+}
@@ -10,18 +10,51 @@ import (
 type Option func(*builder)
 
 type builder struct {
-	exp *backoff.ExponentialBackOff
-	max *uint64
+	exp     *backoff.ExponentialBackOff
+	custom  BackOff
+	max     uint64
+	hasMax  bool
+	retryIf func(error) bool
+	name    string
+	budget  *Budget
+
+	// err is the error of the options, returned by retry without calling fn.
+	err error
+
+	// expv is the storage of exp for the builder from the pool.
+	expv backoff.ExponentialBackOff
+
+	onStart   []func(*state)
+	onAttempt []func(*state)
+	onResult  []func(*state)
+	onRetry   []func(*state)
+	onDone    []func(*state)
 }
 
 func (bu *builder) build() (b backoff.BackOff) {
-	b = bu.exp
-	if bu.max != nil {
-		b = backoff.WithMaxRetries(b, *bu.max)
+	if bu.err != nil {
+		return &backoff.StopBackOff{}
+	}
+	b = bu.backOff()
+	if bu.hasMax {
+		b = backoff.WithMaxRetries(b, bu.max)
 	}
 	return
 }
 
+// backOff returns the BackOff of the options without MaxRetries.
+func (bu *builder) backOff() backoff.BackOff {
+	if bu.custom != nil {
+		return ToCenkalti(bu.custom)
+	}
+	return bu.exp
+}
+
+// hooked reports whether any hook is set.
+func (bu *builder) hooked() bool {
+	return len(bu.onStart)+len(bu.onAttempt)+len(bu.onResult)+len(bu.onRetry)+len(bu.onDone) > 0
+}
+
 // InitialInterval uses d as InitialInterval.
 //
 // see: https://pkg.go.dev/github.com/cenkalti/backoff/v4#ExponentialBackOff
@@ -71,7 +104,38 @@ func Clock(clock backoff.Clock) Option {
 	return func(bu *builder) { bu.exp.Clock = clock }
 }
 
+// WithBackOff uses b instead of ExponentialBackOff.
+//
+// The options of ExponentialBackOff such as [InitialInterval] are ignored,
+// while [MaxRetries] is applied to b.
+// b is either an implementation of this package or of "github.com/cenkalti/backoff/v4" through [FromCenkalti].
+//
+// b is stateful, so it must not be shared by the retry operations running concurrently,
+// e.g. by [RetryEach], or by Transport of backoffhttp and Dialer of backoffnet serving concurrent requests.
+// Use [WithBackOffFunc] for them.
+func WithBackOff(b BackOff) Option {
+	return func(bu *builder) { bu.custom = b }
+}
+
+// WithBackOffFunc uses the BackOff returned by fn instead of ExponentialBackOff, as [WithBackOff].
+//
+// fn is called every time the option is applied, so that each retry operation has its own BackOff.
+func WithBackOffFunc(fn func() BackOff) Option {
+	return func(bu *builder) { bu.custom = fn() }
+}
+
 // MaxRetries applies [backoff.WithMaxRetries] with max.
 func MaxRetries(max uint64) Option {
-	return func(bu *builder) { bu.max = &max }
+	return func(bu *builder) { bu.max, bu.hasMax = max, true }
+}
+
+// RetryIf retries only errors for which pred returns true.
+// The other errors are returned immediately as if they were wrapped by [backoff.Permanent].
+func RetryIf(pred func(error) bool) Option {
+	return func(bu *builder) { bu.retryIf = pred }
+}
+
+// Operation names the retry operation, which is used as the label by [Logger], [WithMetrics] and [WithTracer].
+func Operation(name string) Option {
+	return func(bu *builder) { bu.name = name }
 }
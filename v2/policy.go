@@ -0,0 +1,265 @@
+package backoff
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kind is the kind of strategy of [Policy].
+type Kind string
+
+// Kinds of strategy.
+const (
+	// KindExponential is the strategy of ExponentialBackOff.
+	KindExponential Kind = "exp"
+
+	// KindConstant is the strategy that waits the same interval every time.
+	// It is implemented by ExponentialBackOff with Multiplier 1.
+	KindConstant Kind = "const"
+)
+
+// Policy is the retry policy described by a spec string.
+//
+// The fields other than Kind are nil unless they are specified.
+//
+// The grammar of the spec is like below.
+//
+//	exp(initial=100ms,max=30s,mult=2,jitter=0.3,retries=5,elapsed=2m)
+//	const(1s,jitter=0.1,retries=10,elapsed=1m)
+//
+// The parameters are
+//
+//   - initial: InitialInterval (exp only)
+//   - max: MaxInterval (exp only)
+//   - mult: Multiplier (exp only)
+//   - jitter: RandomizationFactor
+//   - retries: MaxRetries
+//   - elapsed: MaxElapsedTime
+//
+// The first parameter of const is the interval, which may be also written as interval=1s.
+type Policy struct {
+	Kind                Kind
+	InitialInterval     *time.Duration
+	RandomizationFactor *float64
+	Multiplier          *float64
+	MaxInterval         *time.Duration
+	MaxElapsedTime      *time.Duration
+	MaxRetries          *uint64
+}
+
+// Parse parses spec, returns the options of the policy.
+//
+// see: [Policy]
+func Parse(spec string) ([]Option, error) {
+	p, err := ParsePolicy(spec)
+	if err != nil {
+		return nil, err
+	}
+	return p.Options(), nil
+}
+
+// ParsePolicy parses spec, returns the policy.
+//
+// see: [Policy]
+func ParsePolicy(spec string) (*Policy, error) {
+	s := strings.TrimSpace(spec)
+	open := strings.IndexByte(s, '(')
+	if open < 0 || !strings.HasSuffix(s, ")") {
+		return nil, fmt.Errorf("backoff: invalid policy %q: expected kind(parameters)", spec)
+	}
+
+	p := &Policy{Kind: Kind(strings.TrimSpace(s[:open]))}
+	switch p.Kind {
+	case KindExponential, KindConstant:
+	default:
+		return nil, fmt.Errorf("backoff: invalid policy %q: unknown kind %q", spec, p.Kind)
+	}
+
+	args := s[open+1 : len(s)-1]
+	if strings.TrimSpace(args) != "" {
+		for i, arg := range strings.Split(args, ",") {
+			key, value, ok := strings.Cut(arg, "=")
+			key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+			if !ok {
+				if i != 0 || p.Kind != KindConstant {
+					return nil, fmt.Errorf("backoff: invalid policy %q: expected key=value: %q", spec, arg)
+				}
+				key, value = "interval", key
+			}
+			if err := p.set(key, value); err != nil {
+				return nil, fmt.Errorf("backoff: invalid policy %q: %w", spec, err)
+			}
+		}
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("backoff: invalid policy %q: %w", spec, err)
+	}
+	return p, nil
+}
+
+// Validate reports an error if the policy has an unknown kind, a missing interval of const,
+// a parameter not applicable to the kind, or a parameter out of range.
+func (p *Policy) Validate() error {
+	switch p.Kind {
+	case KindExponential:
+	case KindConstant:
+		if p.InitialInterval == nil {
+			return errors.New("interval is required")
+		}
+		if p.MaxInterval != nil || p.Multiplier != nil {
+			return errors.New("max and mult are not applicable to const")
+		}
+	default:
+		return fmt.Errorf("unknown kind %q", p.Kind)
+	}
+	for _, d := range []struct {
+		name  string
+		value *time.Duration
+	}{
+		{"initial", p.InitialInterval},
+		{"max", p.MaxInterval},
+		{"elapsed", p.MaxElapsedTime},
+	} {
+		if d.value != nil && *d.value < 0 {
+			return fmt.Errorf("%s: negative duration %v", d.name, *d.value)
+		}
+	}
+	if f := p.Multiplier; f != nil && !(*f > 0 && !math.IsInf(*f, 0)) {
+		return fmt.Errorf("mult: out of range %v", *f)
+	}
+	if f := p.RandomizationFactor; f != nil && !(0 <= *f && *f <= 1) {
+		return fmt.Errorf("jitter: out of range %v", *f)
+	}
+	return nil
+}
+
+var errDuplicated = errors.New("duplicated")
+
+func (p *Policy) set(key, value string) (err error) {
+	switch {
+	case key == "initial" && p.Kind == KindExponential, key == "interval" && p.Kind == KindConstant:
+		err = setDuration(&p.InitialInterval, value)
+	case key == "max" && p.Kind == KindExponential:
+		err = setDuration(&p.MaxInterval, value)
+	case key == "mult" && p.Kind == KindExponential:
+		err = setFloat(&p.Multiplier, value)
+	case key == "jitter":
+		err = setFloat(&p.RandomizationFactor, value)
+	case key == "retries":
+		if p.MaxRetries != nil {
+			return fmt.Errorf("%s: %w", key, errDuplicated)
+		}
+		var n uint64
+		n, err = strconv.ParseUint(value, 10, 64)
+		p.MaxRetries = &n
+	case key == "elapsed":
+		err = setDuration(&p.MaxElapsedTime, value)
+	default:
+		return fmt.Errorf("unknown parameter %q for %s", key, p.Kind)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	return nil
+}
+
+func setDuration(dst **time.Duration, value string) error {
+	if *dst != nil {
+		return errDuplicated
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+	*dst = &d
+	return nil
+}
+
+func setFloat(dst **float64, value string) error {
+	if *dst != nil {
+		return errDuplicated
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return err
+	}
+	*dst = &f
+	return nil
+}
+
+// Options returns the options of the policy.
+func (p *Policy) Options() []Option {
+	var options []Option
+	if p.Kind == KindConstant {
+		jitter := 0.0
+		if p.RandomizationFactor != nil {
+			jitter = *p.RandomizationFactor
+		}
+		if p.InitialInterval != nil {
+			options = append(options, InitialInterval(*p.InitialInterval), MaxInterval(*p.InitialInterval))
+		}
+		options = append(options, Multiplier(1), RandomizationFactor(jitter))
+	} else {
+		if p.InitialInterval != nil {
+			options = append(options, InitialInterval(*p.InitialInterval))
+		}
+		if p.MaxInterval != nil {
+			options = append(options, MaxInterval(*p.MaxInterval))
+		}
+		if p.Multiplier != nil {
+			options = append(options, Multiplier(*p.Multiplier))
+		}
+		if p.RandomizationFactor != nil {
+			options = append(options, RandomizationFactor(*p.RandomizationFactor))
+		}
+	}
+	if p.MaxRetries != nil {
+		options = append(options, MaxRetries(*p.MaxRetries))
+	}
+	if p.MaxElapsedTime != nil {
+		options = append(options, MaxElapsedTime(*p.MaxElapsedTime))
+	}
+	return options
+}
+
+// String returns the spec of the policy, which can be parsed by [ParsePolicy].
+func (p *Policy) String() string {
+	var args []string
+	add := func(key, value string) {
+		if key == "" {
+			args = append(args, value)
+		} else {
+			args = append(args, key+"="+value)
+		}
+	}
+	if p.Kind == KindConstant {
+		if p.InitialInterval != nil {
+			add("", p.InitialInterval.String())
+		}
+	} else {
+		if p.InitialInterval != nil {
+			add("initial", p.InitialInterval.String())
+		}
+		if p.MaxInterval != nil {
+			add("max", p.MaxInterval.String())
+		}
+		if p.Multiplier != nil {
+			add("mult", strconv.FormatFloat(*p.Multiplier, 'g', -1, 64))
+		}
+	}
+	if p.RandomizationFactor != nil {
+		add("jitter", strconv.FormatFloat(*p.RandomizationFactor, 'g', -1, 64))
+	}
+	if p.MaxRetries != nil {
+		add("retries", strconv.FormatUint(*p.MaxRetries, 10))
+	}
+	if p.MaxElapsedTime != nil {
+		add("elapsed", p.MaxElapsedTime.String())
+	}
+	return string(p.Kind) + "(" + strings.Join(args, ",") + ")"
+}
@@ -0,0 +1,88 @@
+package backoff_test
+
+import (
+	"testing"
+	"time"
+
+	cenkalti "github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestParsePolicy(t *testing.T) {
+	t.Run("exp", func(t *testing.T) {
+		p, err := backoff.ParsePolicy("exp(initial=100ms, max=30s, mult=2, jitter=0.3, retries=5, elapsed=2m)")
+		require.NoError(t, err)
+		assert.Equal(t, "exp(initial=100ms,max=30s,mult=2,jitter=0.3,retries=5,elapsed=2m0s)", p.String())
+
+		exp := cenkalti.NewExponentialBackOff()
+		backoff.Apply(exp, p.Options()...)
+		assert.Equal(t, 100*time.Millisecond, exp.InitialInterval)
+		assert.Equal(t, 30*time.Second, exp.MaxInterval)
+		assert.Equal(t, 2.0, exp.Multiplier)
+		assert.Equal(t, 0.3, exp.RandomizationFactor)
+		assert.Equal(t, 2*time.Minute, exp.MaxElapsedTime)
+	})
+
+	t.Run("const", func(t *testing.T) {
+		p, err := backoff.ParsePolicy("const(1s,retries=10)")
+		require.NoError(t, err)
+		assert.Equal(t, "const(1s,retries=10)", p.String())
+
+		exp := cenkalti.NewExponentialBackOff()
+		b := backoff.Apply(exp, p.Options()...)
+		b.Reset()
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, time.Second, b.NextBackOff())
+		}
+		assert.Equal(t, cenkalti.Stop, b.NextBackOff())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		p, err := backoff.ParsePolicy("exp()")
+		require.NoError(t, err)
+		assert.Equal(t, "exp()", p.String())
+		assert.Empty(t, p.Options())
+	})
+
+	for _, spec := range []string{
+		"",
+		"exp",
+		"linear(1s)",
+		"exp(1s)",
+		"exp(initial=1s,initial=2s)",
+		"exp(initial=-1s)",
+		"exp(mult=0)",
+		"exp(jitter=2)",
+		"exp(jitter=NaN)",
+		"exp(retries=-1)",
+		"exp(interval=1s)",
+		"const()",
+		"const(1s,max=2s)",
+		"const(retries=3)",
+	} {
+		_, err := backoff.Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func FuzzParsePolicy(f *testing.F) {
+	f.Add("exp(initial=100ms,max=30s,mult=2,jitter=0.3,retries=5,elapsed=2m)")
+	f.Add("const(1s,retries=10)")
+	f.Add("const(interval=1h,jitter=0.5,elapsed=0s)")
+	f.Add("exp()")
+	f.Fuzz(func(t *testing.T, spec string) {
+		p, err := backoff.ParsePolicy(spec)
+		if err != nil {
+			return
+		}
+		s := p.String()
+		q, err := backoff.ParsePolicy(s)
+		if err != nil {
+			t.Fatalf("ParsePolicy(%q) of ParsePolicy(%q): %v", s, spec, err)
+		}
+		assert.Equal(t, p, q)
+		assert.Equal(t, s, q.String())
+	})
+}
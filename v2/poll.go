@@ -0,0 +1,67 @@
+package backoff
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+// ErrNotReady is returned by [Until] and [Poll] when BackOff stops before the condition becomes ready.
+var ErrNotReady = errors.New("backoff: not ready")
+
+// Until calls cond until it returns true, returns an error, or BackOff stops.
+//
+// BackOff is created by [NewContext] with options and ctx.
+//
+// cond returning false with nil error means "not ready yet", and it is called again after the backoff.
+// The error returned by cond is returned immediately without retrying.
+// If BackOff stops before cond returns true, Until returns [ErrNotReady], or the error of ctx.
+func Until(ctx context.Context, cond func(context.Context) (bool, error), options ...Option) error {
+	_, err := Poll(
+		ctx,
+		func(ctx context.Context) (struct{}, bool, error) {
+			ok, err := cond(ctx)
+			return struct{}{}, ok, err
+		},
+		options...,
+	)
+	return err
+}
+
+// Poll calls fn until it returns true, returns an error, or BackOff stops, returns the value of the last call.
+//
+// BackOff is created by [NewContext] with options and ctx.
+//
+// fn is called with the context of each attempt derived from ctx, e.g. by [WithTracer].
+// fn returning false with nil error means "not ready yet", and it is called again after the backoff.
+// The error returned by fn is returned immediately without retrying.
+// "Not ready" is always retried regardless of [RetryIf].
+// If BackOff stops before fn returns true, Poll returns [ErrNotReady], or the error of ctx.
+func Poll[T any](ctx context.Context, fn func(context.Context) (T, bool, error), options ...Option) (v T, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) error {
+			var ok bool
+			var err error
+			v, ok, err = fn(ctx)
+			switch {
+			case err != nil:
+				return backoff.Permanent(err)
+			case !ok:
+				return ErrNotReady
+			}
+			return nil
+		},
+		append(options[:len(options):len(options)], retryNotReady),
+	)
+	return
+}
+
+// retryNotReady makes RetryIf always retry [ErrNotReady],
+// because "not ready" is not an error to be classified by the predicate.
+func retryNotReady(bu *builder) {
+	if pred := bu.retryIf; pred != nil {
+		bu.retryIf = func(err error) bool { return errors.Is(err, ErrNotReady) || pred(err) }
+	}
+}
@@ -0,0 +1,119 @@
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestUntil(t *testing.T) {
+	t.Run("ready", func(t *testing.T) {
+		n := 0
+		err := backoff.Until(
+			context.Background(),
+			func(context.Context) (bool, error) {
+				n++
+				return n == 3, nil
+			},
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(5),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, n)
+	})
+
+	t.Run("not ready", func(t *testing.T) {
+		n := 0
+		err := backoff.Until(
+			context.Background(),
+			func(context.Context) (bool, error) {
+				n++
+				return false, nil
+			},
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(3),
+		)
+		assert.ErrorIs(t, err, backoff.ErrNotReady)
+		assert.Equal(t, 4, n)
+	})
+
+	t.Run("RetryIf", func(t *testing.T) {
+		n := 0
+		err := backoff.Until(
+			context.Background(),
+			func(context.Context) (bool, error) {
+				n++
+				return n == 3, nil
+			},
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(5),
+			backoff.RetryIf(func(error) bool { return false }),
+		)
+		// RetryIf は not ready を止めない
+		assert.NoError(t, err)
+		assert.Equal(t, 3, n)
+	})
+
+	t.Run("fatal", func(t *testing.T) {
+		n := 0
+		fatal := errors.New("fatal")
+		err := backoff.Until(
+			context.Background(),
+			func(context.Context) (bool, error) {
+				n++
+				return false, fatal
+			},
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(3),
+			// RetryIf は fn のエラーに影響しない
+			backoff.RetryIf(func(error) bool { return false }),
+		)
+		assert.ErrorIs(t, err, fatal)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel() // キャンセル
+
+		n := 0
+		err := backoff.Until(
+			ctx,
+			func(context.Context) (bool, error) {
+				n++
+				return false, nil
+			},
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+		)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, n)
+	})
+}
+
+func TestPoll(t *testing.T) {
+	n := 0
+	v, err := backoff.Poll(
+		context.Background(),
+		func(context.Context) (string, bool, error) {
+			n++
+			if n < 3 {
+				return "", false, nil
+			}
+			return "ready", true, nil
+		},
+		backoff.InitialInterval(1),
+		backoff.MaxInterval(1),
+		backoff.MaxRetries(5),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "ready", v)
+	assert.Equal(t, 3, n)
+}
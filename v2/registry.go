@@ -0,0 +1,115 @@
+package backoff
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrUnknownPolicy is the error returned by the retry with [UsePolicy] with the name not registered.
+var ErrUnknownPolicy = errors.New("backoff: unknown policy")
+
+// registry is the policies registered by name.
+var registry = struct {
+	sync.RWMutex
+	policies map[string]*Policy
+}{
+	policies: map[string]*Policy{
+		"default":    mustParsePolicy("exp()"),
+		"aggressive": mustParsePolicy("exp(initial=50ms,max=1s,mult=1.5,jitter=0.5,elapsed=10s)"),
+		"patient":    mustParsePolicy("exp(initial=1s,max=5m,mult=2,jitter=0.5,elapsed=1h)"),
+		"polling":    mustParsePolicy("const(1s,jitter=0.1)"),
+	},
+}
+
+func mustParsePolicy(spec string) *Policy {
+	p, err := ParsePolicy(spec)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Register registers p by name, replacing the policy already registered by name.
+//
+// The presets below are registered initially.
+//
+//   - default: exp()
+//   - aggressive: exp(initial=50ms,max=1s,mult=1.5,jitter=0.5,elapsed=10s)
+//   - patient: exp(initial=1s,max=5m,mult=2,jitter=0.5,elapsed=1h)
+//   - polling: const(1s,jitter=0.1)
+//
+// p must not be modified after registered.
+// Register panics if p is nil.
+func Register(name string, p *Policy) {
+	if p == nil {
+		panic("backoff: Register policy is nil")
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	registry.policies[name] = p
+}
+
+// RegisterConfig registers the policies of configs by name, typically loaded from a configuration file.
+// If any of configs is invalid, none of them are registered.
+func RegisterConfig(configs map[string]Config) error {
+	policies := make(map[string]*Policy, len(configs))
+	for name, c := range configs {
+		p, err := c.Policy()
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		policies[name] = p
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	for name, p := range policies {
+		registry.policies[name] = p
+	}
+	return nil
+}
+
+// Lookup returns the policy registered by name.
+func Lookup(name string) (p *Policy, ok bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	p, ok = registry.policies[name]
+	return
+}
+
+// Named returns the policy registered by name.
+// If name is not registered, the policy registered by "default" is returned.
+//
+// The returned policy must not be modified.
+func Named(name string) *Policy {
+	if p, ok := Lookup(name); ok {
+		return p
+	}
+	if p, ok := Lookup("default"); ok {
+		return p
+	}
+	return &Policy{Kind: KindExponential}
+}
+
+// UsePolicy applies the options of the policy registered by name.
+//
+// The policy is looked up every time the option is applied,
+// so that the policy registered later takes effect.
+//
+// Unlike [Named], UsePolicy does not fall back to "default".
+// If name is not registered, the Retry* helpers return [ErrUnknownPolicy] without calling fn,
+// [Attempts] yields the first attempt with the error,
+// and the BackOff returned by [New] and [Apply] stops without retrying.
+func UsePolicy(name string) Option {
+	return func(bu *builder) {
+		p, ok := Lookup(name)
+		if !ok {
+			bu.err = fmt.Errorf("%w: %q", ErrUnknownPolicy, name)
+			return
+		}
+		for _, opt := range p.Options() {
+			opt(bu)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package backoff_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	cenkalti "github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestNamed(t *testing.T) {
+	for _, name := range []string{"default", "aggressive", "patient", "polling"} {
+		_, ok := backoff.Lookup(name)
+		assert.True(t, ok, name)
+	}
+
+	assert.Equal(t, backoff.Named("default"), backoff.Named("no-such-policy"))
+
+	p, err := backoff.ParsePolicy("const(1ms,retries=2)")
+	require.NoError(t, err)
+	backoff.Register("test-named", p)
+	assert.Equal(t, p, backoff.Named("test-named"))
+}
+
+func TestRegisterConfig(t *testing.T) {
+	d := backoff.Duration(time.Millisecond)
+	err := backoff.RegisterConfig(map[string]backoff.Config{
+		"test-config": {Kind: backoff.KindConstant, InitialInterval: &d},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "const(1ms)", backoff.Named("test-config").String())
+
+	err = backoff.RegisterConfig(map[string]backoff.Config{
+		"test-invalid": {Kind: backoff.KindConstant},
+	})
+	assert.Error(t, err)
+	_, ok := backoff.Lookup("test-invalid")
+	assert.False(t, ok)
+}
+
+func TestUsePolicy(t *testing.T) {
+	p, err := backoff.ParsePolicy("const(1ms,retries=2)")
+	require.NoError(t, err)
+	backoff.Register("test-use-policy", p)
+
+	n := 0
+	never := errors.New("never")
+	err = backoff.Retry(
+		func() error {
+			n++
+			return never
+		},
+		backoff.UsePolicy("test-use-policy"),
+	)
+	assert.ErrorIs(t, err, never)
+	assert.Equal(t, 3, n)
+
+	// 後から登録したポリシーが有効になる
+	exp := cenkalti.NewExponentialBackOff()
+	option := backoff.UsePolicy("test-use-policy-later")
+	backoff.Register("test-use-policy-later", p)
+	backoff.Apply(exp, option)
+	assert.Equal(t, time.Millisecond, exp.InitialInterval)
+}
+
+func TestUsePolicyUnknown(t *testing.T) {
+	n := 0
+	err := backoff.Retry(
+		func() error {
+			n++
+			return nil
+		},
+		backoff.UsePolicy("test-no-such-policy"),
+	)
+	assert.ErrorIs(t, err, backoff.ErrUnknownPolicy)
+	// 未登録のポリシーでは fn を実行しない
+	assert.Equal(t, 0, n)
+
+	// New はリトライしない BackOff を返す
+	b := backoff.New(backoff.UsePolicy("test-no-such-policy"))
+	assert.Equal(t, cenkalti.Stop, b.NextBackOff())
+}
+
+func TestRegisterNil(t *testing.T) {
+	assert.Panics(t, func() { backoff.Register("test-nil", nil) })
+	_, ok := backoff.Lookup("test-nil")
+	assert.False(t, ok)
+}
+
+func TestPresets(t *testing.T) {
+	// Register のドキュメントと同じ spec
+	for name, spec := range map[string]string{
+		"default":    "exp()",
+		"aggressive": "exp(initial=50ms,max=1s,mult=1.5,jitter=0.5,elapsed=10s)",
+		"patient":    "exp(initial=1s,max=5m,mult=2,jitter=0.5,elapsed=1h)",
+		"polling":    "const(1s,jitter=0.1)",
+	} {
+		p, err := backoff.ParsePolicy(spec)
+		require.NoError(t, err)
+		assert.Equal(t, p, backoff.Named(name), name)
+	}
+}
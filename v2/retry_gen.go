@@ -0,0 +1,769 @@
+// Code generated by genretry. DO NOT EDIT.
+
+package backoff
+
+import (
+	"context"
+
+	"github.com/takumakei/go-bind"
+)
+
+// RetryR1 is an alias of [Retry].
+func RetryR1(fn func() error, options ...Option) error {
+	return Retry(fn, options...)
+}
+
+// RetryContextR1 is an alias of [RetryContext].
+func RetryContextR1(ctx context.Context, fn func() error, options ...Option) error {
+	return RetryContext(ctx, fn, options...)
+}
+
+// RetryR2 the function fn that returns 2 values until it does not return error or BackOff stops.
+func RetryR2[R1 any](fn func() (R1, error), options ...Option) (r1 R1, err error) {
+	err = retry(
+		context.Background(),
+		func(context.Context) (err error) {
+			r1, err = fn()
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryContextR2 the function fn that returns 2 values until it does not return error or BackOff stops.
+func RetryContextR2[R1 any](ctx context.Context, fn func() (R1, error), options ...Option) (r1 R1, err error) {
+	err = retry(
+		ctx,
+		func(context.Context) (err error) {
+			r1, err = fn()
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryR3 the function fn that returns 3 values until it does not return error or BackOff stops.
+func RetryR3[R1, R2 any](fn func() (R1, R2, error), options ...Option) (r1 R1, r2 R2, err error) {
+	err = retry(
+		context.Background(),
+		func(context.Context) (err error) {
+			r1, r2, err = fn()
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryContextR3 the function fn that returns 3 values until it does not return error or BackOff stops.
+func RetryContextR3[R1, R2 any](ctx context.Context, fn func() (R1, R2, error), options ...Option) (r1 R1, r2 R2, err error) {
+	err = retry(
+		ctx,
+		func(context.Context) (err error) {
+			r1, r2, err = fn()
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryR4 the function fn that returns 4 values until it does not return error or BackOff stops.
+func RetryR4[R1, R2, R3 any](fn func() (R1, R2, R3, error), options ...Option) (r1 R1, r2 R2, r3 R3, err error) {
+	err = retry(
+		context.Background(),
+		func(context.Context) (err error) {
+			r1, r2, r3, err = fn()
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryContextR4 the function fn that returns 4 values until it does not return error or BackOff stops.
+func RetryContextR4[R1, R2, R3 any](ctx context.Context, fn func() (R1, R2, R3, error), options ...Option) (r1 R1, r2 R2, r3 R3, err error) {
+	err = retry(
+		ctx,
+		func(context.Context) (err error) {
+			r1, r2, r3, err = fn()
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryR5 the function fn that returns 5 values until it does not return error or BackOff stops.
+func RetryR5[R1, R2, R3, R4 any](fn func() (R1, R2, R3, R4, error), options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
+	err = retry(
+		context.Background(),
+		func(context.Context) (err error) {
+			r1, r2, r3, r4, err = fn()
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryContextR5 the function fn that returns 5 values until it does not return error or BackOff stops.
+func RetryContextR5[R1, R2, R3, R4 any](ctx context.Context, fn func() (R1, R2, R3, R4, error), options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
+	err = retry(
+		ctx,
+		func(context.Context) (err error) {
+			r1, r2, r3, r4, err = fn()
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryR6 the function fn that returns 6 values until it does not return error or BackOff stops.
+func RetryR6[R1, R2, R3, R4, R5 any](fn func() (R1, R2, R3, R4, R5, error), options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
+	err = retry(
+		context.Background(),
+		func(context.Context) (err error) {
+			r1, r2, r3, r4, r5, err = fn()
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryContextR6 the function fn that returns 6 values until it does not return error or BackOff stops.
+func RetryContextR6[R1, R2, R3, R4, R5 any](ctx context.Context, fn func() (R1, R2, R3, R4, R5, error), options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
+	err = retry(
+		ctx,
+		func(context.Context) (err error) {
+			r1, r2, r3, r4, r5, err = fn()
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryR7 the function fn that returns 7 values until it does not return error or BackOff stops.
+func RetryR7[R1, R2, R3, R4, R5, R6 any](fn func() (R1, R2, R3, R4, R5, R6, error), options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
+	err = retry(
+		context.Background(),
+		func(context.Context) (err error) {
+			r1, r2, r3, r4, r5, r6, err = fn()
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryContextR7 the function fn that returns 7 values until it does not return error or BackOff stops.
+func RetryContextR7[R1, R2, R3, R4, R5, R6 any](ctx context.Context, fn func() (R1, R2, R3, R4, R5, R6, error), options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
+	err = retry(
+		ctx,
+		func(context.Context) (err error) {
+			r1, r2, r3, r4, r5, r6, err = fn()
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryR8 the function fn that returns 8 values until it does not return error or BackOff stops.
+func RetryR8[R1, R2, R3, R4, R5, R6, R7 any](fn func() (R1, R2, R3, R4, R5, R6, R7, error), options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
+	err = retry(
+		context.Background(),
+		func(context.Context) (err error) {
+			r1, r2, r3, r4, r5, r6, r7, err = fn()
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryContextR8 the function fn that returns 8 values until it does not return error or BackOff stops.
+func RetryContextR8[R1, R2, R3, R4, R5, R6, R7 any](ctx context.Context, fn func() (R1, R2, R3, R4, R5, R6, R7, error), options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
+	err = retry(
+		ctx,
+		func(context.Context) (err error) {
+			r1, r2, r3, r4, r5, r6, r7, err = fn()
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP1R1 is [RetryR1] with fn binding one parameter.
+func RetryP1R1[P1 any](fn func(P1) error, p1 P1, options ...Option) error {
+	return RetryR1(bind.P1R1(fn, p1), options...)
+}
+
+// RetryContextP1R1 is [RetryContextR1] with fn taking the context of each attempt and binding one parameter.
+func RetryContextP1R1[P1 any](ctx context.Context, fn func(context.Context, P1) error, p1 P1, options ...Option) error {
+	return retry(
+		ctx,
+		func(ctx context.Context) error {
+			return fn(ctx, p1)
+		},
+		options,
+	)
+}
+
+// RetryP1R2 is [RetryR2] with fn binding one parameter.
+func RetryP1R2[P1, R1 any](fn func(P1) (R1, error), p1 P1, options ...Option) (R1, error) {
+	return RetryR2(bind.P1R2(fn, p1), options...)
+}
+
+// RetryContextP1R2 is [RetryContextR2] with fn taking the context of each attempt and binding one parameter.
+func RetryContextP1R2[P1, R1 any](ctx context.Context, fn func(context.Context, P1) (R1, error), p1 P1, options ...Option) (r1 R1, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, err = fn(ctx, p1)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP1R3 is [RetryR3] with fn binding one parameter.
+func RetryP1R3[P1, R1, R2 any](fn func(P1) (R1, R2, error), p1 P1, options ...Option) (R1, R2, error) {
+	return RetryR3(bind.P1R3(fn, p1), options...)
+}
+
+// RetryContextP1R3 is [RetryContextR3] with fn taking the context of each attempt and binding one parameter.
+func RetryContextP1R3[P1, R1, R2 any](ctx context.Context, fn func(context.Context, P1) (R1, R2, error), p1 P1, options ...Option) (r1 R1, r2 R2, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, err = fn(ctx, p1)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP1R4 is [RetryR4] with fn binding one parameter.
+func RetryP1R4[P1, R1, R2, R3 any](fn func(P1) (R1, R2, R3, error), p1 P1, options ...Option) (R1, R2, R3, error) {
+	return RetryR4(bind.P1R4(fn, p1), options...)
+}
+
+// RetryContextP1R4 is [RetryContextR4] with fn taking the context of each attempt and binding one parameter.
+func RetryContextP1R4[P1, R1, R2, R3 any](ctx context.Context, fn func(context.Context, P1) (R1, R2, R3, error), p1 P1, options ...Option) (r1 R1, r2 R2, r3 R3, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, err = fn(ctx, p1)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP1R5 is [RetryR5] with fn binding one parameter.
+func RetryP1R5[P1, R1, R2, R3, R4 any](fn func(P1) (R1, R2, R3, R4, error), p1 P1, options ...Option) (R1, R2, R3, R4, error) {
+	return RetryR5(bind.P1R5(fn, p1), options...)
+}
+
+// RetryContextP1R5 is [RetryContextR5] with fn taking the context of each attempt and binding one parameter.
+func RetryContextP1R5[P1, R1, R2, R3, R4 any](ctx context.Context, fn func(context.Context, P1) (R1, R2, R3, R4, error), p1 P1, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, err = fn(ctx, p1)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP1R6 is [RetryR6] with fn binding one parameter.
+func RetryP1R6[P1, R1, R2, R3, R4, R5 any](fn func(P1) (R1, R2, R3, R4, R5, error), p1 P1, options ...Option) (R1, R2, R3, R4, R5, error) {
+	return RetryR6(bind.P1R6(fn, p1), options...)
+}
+
+// RetryContextP1R6 is [RetryContextR6] with fn taking the context of each attempt and binding one parameter.
+func RetryContextP1R6[P1, R1, R2, R3, R4, R5 any](ctx context.Context, fn func(context.Context, P1) (R1, R2, R3, R4, R5, error), p1 P1, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, r5, err = fn(ctx, p1)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP1R7 is [RetryR7] with fn binding one parameter.
+func RetryP1R7[P1, R1, R2, R3, R4, R5, R6 any](fn func(P1) (R1, R2, R3, R4, R5, R6, error), p1 P1, options ...Option) (R1, R2, R3, R4, R5, R6, error) {
+	return RetryR7(bind.P1R7(fn, p1), options...)
+}
+
+// RetryContextP1R7 is [RetryContextR7] with fn taking the context of each attempt and binding one parameter.
+func RetryContextP1R7[P1, R1, R2, R3, R4, R5, R6 any](ctx context.Context, fn func(context.Context, P1) (R1, R2, R3, R4, R5, R6, error), p1 P1, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, r5, r6, err = fn(ctx, p1)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP1R8 is [RetryR8] with fn binding one parameter.
+func RetryP1R8[P1, R1, R2, R3, R4, R5, R6, R7 any](fn func(P1) (R1, R2, R3, R4, R5, R6, R7, error), p1 P1, options ...Option) (R1, R2, R3, R4, R5, R6, R7, error) {
+	return RetryR8(bind.P1R8(fn, p1), options...)
+}
+
+// RetryContextP1R8 is [RetryContextR8] with fn taking the context of each attempt and binding one parameter.
+func RetryContextP1R8[P1, R1, R2, R3, R4, R5, R6, R7 any](ctx context.Context, fn func(context.Context, P1) (R1, R2, R3, R4, R5, R6, R7, error), p1 P1, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, r5, r6, r7, err = fn(ctx, p1)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP2R1 is [RetryR1] with fn binding 2 parameters.
+func RetryP2R1[P1, P2 any](fn func(P1, P2) error, p1 P1, p2 P2, options ...Option) error {
+	return RetryR1(bind.P2R1(fn, p1, p2), options...)
+}
+
+// RetryContextP2R1 is [RetryContextR1] with fn taking the context of each attempt and binding 2 parameters.
+func RetryContextP2R1[P1, P2 any](ctx context.Context, fn func(context.Context, P1, P2) error, p1 P1, p2 P2, options ...Option) error {
+	return retry(
+		ctx,
+		func(ctx context.Context) error {
+			return fn(ctx, p1, p2)
+		},
+		options,
+	)
+}
+
+// RetryP2R2 is [RetryR2] with fn binding 2 parameters.
+func RetryP2R2[P1, P2, R1 any](fn func(P1, P2) (R1, error), p1 P1, p2 P2, options ...Option) (R1, error) {
+	return RetryR2(bind.P2R2(fn, p1, p2), options...)
+}
+
+// RetryContextP2R2 is [RetryContextR2] with fn taking the context of each attempt and binding 2 parameters.
+func RetryContextP2R2[P1, P2, R1 any](ctx context.Context, fn func(context.Context, P1, P2) (R1, error), p1 P1, p2 P2, options ...Option) (r1 R1, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, err = fn(ctx, p1, p2)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP2R3 is [RetryR3] with fn binding 2 parameters.
+func RetryP2R3[P1, P2, R1, R2 any](fn func(P1, P2) (R1, R2, error), p1 P1, p2 P2, options ...Option) (R1, R2, error) {
+	return RetryR3(bind.P2R3(fn, p1, p2), options...)
+}
+
+// RetryContextP2R3 is [RetryContextR3] with fn taking the context of each attempt and binding 2 parameters.
+func RetryContextP2R3[P1, P2, R1, R2 any](ctx context.Context, fn func(context.Context, P1, P2) (R1, R2, error), p1 P1, p2 P2, options ...Option) (r1 R1, r2 R2, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, err = fn(ctx, p1, p2)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP2R4 is [RetryR4] with fn binding 2 parameters.
+func RetryP2R4[P1, P2, R1, R2, R3 any](fn func(P1, P2) (R1, R2, R3, error), p1 P1, p2 P2, options ...Option) (R1, R2, R3, error) {
+	return RetryR4(bind.P2R4(fn, p1, p2), options...)
+}
+
+// RetryContextP2R4 is [RetryContextR4] with fn taking the context of each attempt and binding 2 parameters.
+func RetryContextP2R4[P1, P2, R1, R2, R3 any](ctx context.Context, fn func(context.Context, P1, P2) (R1, R2, R3, error), p1 P1, p2 P2, options ...Option) (r1 R1, r2 R2, r3 R3, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, err = fn(ctx, p1, p2)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP2R5 is [RetryR5] with fn binding 2 parameters.
+func RetryP2R5[P1, P2, R1, R2, R3, R4 any](fn func(P1, P2) (R1, R2, R3, R4, error), p1 P1, p2 P2, options ...Option) (R1, R2, R3, R4, error) {
+	return RetryR5(bind.P2R5(fn, p1, p2), options...)
+}
+
+// RetryContextP2R5 is [RetryContextR5] with fn taking the context of each attempt and binding 2 parameters.
+func RetryContextP2R5[P1, P2, R1, R2, R3, R4 any](ctx context.Context, fn func(context.Context, P1, P2) (R1, R2, R3, R4, error), p1 P1, p2 P2, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, err = fn(ctx, p1, p2)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP2R6 is [RetryR6] with fn binding 2 parameters.
+func RetryP2R6[P1, P2, R1, R2, R3, R4, R5 any](fn func(P1, P2) (R1, R2, R3, R4, R5, error), p1 P1, p2 P2, options ...Option) (R1, R2, R3, R4, R5, error) {
+	return RetryR6(bind.P2R6(fn, p1, p2), options...)
+}
+
+// RetryContextP2R6 is [RetryContextR6] with fn taking the context of each attempt and binding 2 parameters.
+func RetryContextP2R6[P1, P2, R1, R2, R3, R4, R5 any](ctx context.Context, fn func(context.Context, P1, P2) (R1, R2, R3, R4, R5, error), p1 P1, p2 P2, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, r5, err = fn(ctx, p1, p2)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP2R7 is [RetryR7] with fn binding 2 parameters.
+func RetryP2R7[P1, P2, R1, R2, R3, R4, R5, R6 any](fn func(P1, P2) (R1, R2, R3, R4, R5, R6, error), p1 P1, p2 P2, options ...Option) (R1, R2, R3, R4, R5, R6, error) {
+	return RetryR7(bind.P2R7(fn, p1, p2), options...)
+}
+
+// RetryContextP2R7 is [RetryContextR7] with fn taking the context of each attempt and binding 2 parameters.
+func RetryContextP2R7[P1, P2, R1, R2, R3, R4, R5, R6 any](ctx context.Context, fn func(context.Context, P1, P2) (R1, R2, R3, R4, R5, R6, error), p1 P1, p2 P2, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, r5, r6, err = fn(ctx, p1, p2)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP2R8 is [RetryR8] with fn binding 2 parameters.
+func RetryP2R8[P1, P2, R1, R2, R3, R4, R5, R6, R7 any](fn func(P1, P2) (R1, R2, R3, R4, R5, R6, R7, error), p1 P1, p2 P2, options ...Option) (R1, R2, R3, R4, R5, R6, R7, error) {
+	return RetryR8(bind.P2R8(fn, p1, p2), options...)
+}
+
+// RetryContextP2R8 is [RetryContextR8] with fn taking the context of each attempt and binding 2 parameters.
+func RetryContextP2R8[P1, P2, R1, R2, R3, R4, R5, R6, R7 any](ctx context.Context, fn func(context.Context, P1, P2) (R1, R2, R3, R4, R5, R6, R7, error), p1 P1, p2 P2, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, r5, r6, r7, err = fn(ctx, p1, p2)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP3R1 is [RetryR1] with fn binding 3 parameters.
+func RetryP3R1[P1, P2, P3 any](fn func(P1, P2, P3) error, p1 P1, p2 P2, p3 P3, options ...Option) error {
+	return RetryR1(bind.P3R1(fn, p1, p2, p3), options...)
+}
+
+// RetryContextP3R1 is [RetryContextR1] with fn taking the context of each attempt and binding 3 parameters.
+func RetryContextP3R1[P1, P2, P3 any](ctx context.Context, fn func(context.Context, P1, P2, P3) error, p1 P1, p2 P2, p3 P3, options ...Option) error {
+	return retry(
+		ctx,
+		func(ctx context.Context) error {
+			return fn(ctx, p1, p2, p3)
+		},
+		options,
+	)
+}
+
+// RetryP3R2 is [RetryR2] with fn binding 3 parameters.
+func RetryP3R2[P1, P2, P3, R1 any](fn func(P1, P2, P3) (R1, error), p1 P1, p2 P2, p3 P3, options ...Option) (R1, error) {
+	return RetryR2(bind.P3R2(fn, p1, p2, p3), options...)
+}
+
+// RetryContextP3R2 is [RetryContextR2] with fn taking the context of each attempt and binding 3 parameters.
+func RetryContextP3R2[P1, P2, P3, R1 any](ctx context.Context, fn func(context.Context, P1, P2, P3) (R1, error), p1 P1, p2 P2, p3 P3, options ...Option) (r1 R1, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, err = fn(ctx, p1, p2, p3)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP3R3 is [RetryR3] with fn binding 3 parameters.
+func RetryP3R3[P1, P2, P3, R1, R2 any](fn func(P1, P2, P3) (R1, R2, error), p1 P1, p2 P2, p3 P3, options ...Option) (R1, R2, error) {
+	return RetryR3(bind.P3R3(fn, p1, p2, p3), options...)
+}
+
+// RetryContextP3R3 is [RetryContextR3] with fn taking the context of each attempt and binding 3 parameters.
+func RetryContextP3R3[P1, P2, P3, R1, R2 any](ctx context.Context, fn func(context.Context, P1, P2, P3) (R1, R2, error), p1 P1, p2 P2, p3 P3, options ...Option) (r1 R1, r2 R2, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, err = fn(ctx, p1, p2, p3)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP3R4 is [RetryR4] with fn binding 3 parameters.
+func RetryP3R4[P1, P2, P3, R1, R2, R3 any](fn func(P1, P2, P3) (R1, R2, R3, error), p1 P1, p2 P2, p3 P3, options ...Option) (R1, R2, R3, error) {
+	return RetryR4(bind.P3R4(fn, p1, p2, p3), options...)
+}
+
+// RetryContextP3R4 is [RetryContextR4] with fn taking the context of each attempt and binding 3 parameters.
+func RetryContextP3R4[P1, P2, P3, R1, R2, R3 any](ctx context.Context, fn func(context.Context, P1, P2, P3) (R1, R2, R3, error), p1 P1, p2 P2, p3 P3, options ...Option) (r1 R1, r2 R2, r3 R3, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, err = fn(ctx, p1, p2, p3)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP3R5 is [RetryR5] with fn binding 3 parameters.
+func RetryP3R5[P1, P2, P3, R1, R2, R3, R4 any](fn func(P1, P2, P3) (R1, R2, R3, R4, error), p1 P1, p2 P2, p3 P3, options ...Option) (R1, R2, R3, R4, error) {
+	return RetryR5(bind.P3R5(fn, p1, p2, p3), options...)
+}
+
+// RetryContextP3R5 is [RetryContextR5] with fn taking the context of each attempt and binding 3 parameters.
+func RetryContextP3R5[P1, P2, P3, R1, R2, R3, R4 any](ctx context.Context, fn func(context.Context, P1, P2, P3) (R1, R2, R3, R4, error), p1 P1, p2 P2, p3 P3, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, err = fn(ctx, p1, p2, p3)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP3R6 is [RetryR6] with fn binding 3 parameters.
+func RetryP3R6[P1, P2, P3, R1, R2, R3, R4, R5 any](fn func(P1, P2, P3) (R1, R2, R3, R4, R5, error), p1 P1, p2 P2, p3 P3, options ...Option) (R1, R2, R3, R4, R5, error) {
+	return RetryR6(bind.P3R6(fn, p1, p2, p3), options...)
+}
+
+// RetryContextP3R6 is [RetryContextR6] with fn taking the context of each attempt and binding 3 parameters.
+func RetryContextP3R6[P1, P2, P3, R1, R2, R3, R4, R5 any](ctx context.Context, fn func(context.Context, P1, P2, P3) (R1, R2, R3, R4, R5, error), p1 P1, p2 P2, p3 P3, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, r5, err = fn(ctx, p1, p2, p3)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP3R7 is [RetryR7] with fn binding 3 parameters.
+func RetryP3R7[P1, P2, P3, R1, R2, R3, R4, R5, R6 any](fn func(P1, P2, P3) (R1, R2, R3, R4, R5, R6, error), p1 P1, p2 P2, p3 P3, options ...Option) (R1, R2, R3, R4, R5, R6, error) {
+	return RetryR7(bind.P3R7(fn, p1, p2, p3), options...)
+}
+
+// RetryContextP3R7 is [RetryContextR7] with fn taking the context of each attempt and binding 3 parameters.
+func RetryContextP3R7[P1, P2, P3, R1, R2, R3, R4, R5, R6 any](ctx context.Context, fn func(context.Context, P1, P2, P3) (R1, R2, R3, R4, R5, R6, error), p1 P1, p2 P2, p3 P3, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, r5, r6, err = fn(ctx, p1, p2, p3)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP3R8 is [RetryR8] with fn binding 3 parameters.
+func RetryP3R8[P1, P2, P3, R1, R2, R3, R4, R5, R6, R7 any](fn func(P1, P2, P3) (R1, R2, R3, R4, R5, R6, R7, error), p1 P1, p2 P2, p3 P3, options ...Option) (R1, R2, R3, R4, R5, R6, R7, error) {
+	return RetryR8(bind.P3R8(fn, p1, p2, p3), options...)
+}
+
+// RetryContextP3R8 is [RetryContextR8] with fn taking the context of each attempt and binding 3 parameters.
+func RetryContextP3R8[P1, P2, P3, R1, R2, R3, R4, R5, R6, R7 any](ctx context.Context, fn func(context.Context, P1, P2, P3) (R1, R2, R3, R4, R5, R6, R7, error), p1 P1, p2 P2, p3 P3, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, r5, r6, r7, err = fn(ctx, p1, p2, p3)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP4R1 is [RetryR1] with fn binding 4 parameters.
+func RetryP4R1[P1, P2, P3, P4 any](fn func(P1, P2, P3, P4) error, p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) error {
+	return RetryR1(bind.P4R1(fn, p1, p2, p3, p4), options...)
+}
+
+// RetryContextP4R1 is [RetryContextR1] with fn taking the context of each attempt and binding 4 parameters.
+func RetryContextP4R1[P1, P2, P3, P4 any](ctx context.Context, fn func(context.Context, P1, P2, P3, P4) error, p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) error {
+	return retry(
+		ctx,
+		func(ctx context.Context) error {
+			return fn(ctx, p1, p2, p3, p4)
+		},
+		options,
+	)
+}
+
+// RetryP4R2 is [RetryR2] with fn binding 4 parameters.
+func RetryP4R2[P1, P2, P3, P4, R1 any](fn func(P1, P2, P3, P4) (R1, error), p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) (R1, error) {
+	return RetryR2(bind.P4R2(fn, p1, p2, p3, p4), options...)
+}
+
+// RetryContextP4R2 is [RetryContextR2] with fn taking the context of each attempt and binding 4 parameters.
+func RetryContextP4R2[P1, P2, P3, P4, R1 any](ctx context.Context, fn func(context.Context, P1, P2, P3, P4) (R1, error), p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) (r1 R1, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, err = fn(ctx, p1, p2, p3, p4)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP4R3 is [RetryR3] with fn binding 4 parameters.
+func RetryP4R3[P1, P2, P3, P4, R1, R2 any](fn func(P1, P2, P3, P4) (R1, R2, error), p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) (R1, R2, error) {
+	return RetryR3(bind.P4R3(fn, p1, p2, p3, p4), options...)
+}
+
+// RetryContextP4R3 is [RetryContextR3] with fn taking the context of each attempt and binding 4 parameters.
+func RetryContextP4R3[P1, P2, P3, P4, R1, R2 any](ctx context.Context, fn func(context.Context, P1, P2, P3, P4) (R1, R2, error), p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) (r1 R1, r2 R2, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, err = fn(ctx, p1, p2, p3, p4)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP4R4 is [RetryR4] with fn binding 4 parameters.
+func RetryP4R4[P1, P2, P3, P4, R1, R2, R3 any](fn func(P1, P2, P3, P4) (R1, R2, R3, error), p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) (R1, R2, R3, error) {
+	return RetryR4(bind.P4R4(fn, p1, p2, p3, p4), options...)
+}
+
+// RetryContextP4R4 is [RetryContextR4] with fn taking the context of each attempt and binding 4 parameters.
+func RetryContextP4R4[P1, P2, P3, P4, R1, R2, R3 any](ctx context.Context, fn func(context.Context, P1, P2, P3, P4) (R1, R2, R3, error), p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) (r1 R1, r2 R2, r3 R3, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, err = fn(ctx, p1, p2, p3, p4)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP4R5 is [RetryR5] with fn binding 4 parameters.
+func RetryP4R5[P1, P2, P3, P4, R1, R2, R3, R4 any](fn func(P1, P2, P3, P4) (R1, R2, R3, R4, error), p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) (R1, R2, R3, R4, error) {
+	return RetryR5(bind.P4R5(fn, p1, p2, p3, p4), options...)
+}
+
+// RetryContextP4R5 is [RetryContextR5] with fn taking the context of each attempt and binding 4 parameters.
+func RetryContextP4R5[P1, P2, P3, P4, R1, R2, R3, R4 any](ctx context.Context, fn func(context.Context, P1, P2, P3, P4) (R1, R2, R3, R4, error), p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, err = fn(ctx, p1, p2, p3, p4)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP4R6 is [RetryR6] with fn binding 4 parameters.
+func RetryP4R6[P1, P2, P3, P4, R1, R2, R3, R4, R5 any](fn func(P1, P2, P3, P4) (R1, R2, R3, R4, R5, error), p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) (R1, R2, R3, R4, R5, error) {
+	return RetryR6(bind.P4R6(fn, p1, p2, p3, p4), options...)
+}
+
+// RetryContextP4R6 is [RetryContextR6] with fn taking the context of each attempt and binding 4 parameters.
+func RetryContextP4R6[P1, P2, P3, P4, R1, R2, R3, R4, R5 any](ctx context.Context, fn func(context.Context, P1, P2, P3, P4) (R1, R2, R3, R4, R5, error), p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, r5, err = fn(ctx, p1, p2, p3, p4)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP4R7 is [RetryR7] with fn binding 4 parameters.
+func RetryP4R7[P1, P2, P3, P4, R1, R2, R3, R4, R5, R6 any](fn func(P1, P2, P3, P4) (R1, R2, R3, R4, R5, R6, error), p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) (R1, R2, R3, R4, R5, R6, error) {
+	return RetryR7(bind.P4R7(fn, p1, p2, p3, p4), options...)
+}
+
+// RetryContextP4R7 is [RetryContextR7] with fn taking the context of each attempt and binding 4 parameters.
+func RetryContextP4R7[P1, P2, P3, P4, R1, R2, R3, R4, R5, R6 any](ctx context.Context, fn func(context.Context, P1, P2, P3, P4) (R1, R2, R3, R4, R5, R6, error), p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, r5, r6, err = fn(ctx, p1, p2, p3, p4)
+			return
+		},
+		options,
+	)
+	return
+}
+
+// RetryP4R8 is [RetryR8] with fn binding 4 parameters.
+func RetryP4R8[P1, P2, P3, P4, R1, R2, R3, R4, R5, R6, R7 any](fn func(P1, P2, P3, P4) (R1, R2, R3, R4, R5, R6, R7, error), p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) (R1, R2, R3, R4, R5, R6, R7, error) {
+	return RetryR8(bind.P4R8(fn, p1, p2, p3, p4), options...)
+}
+
+// RetryContextP4R8 is [RetryContextR8] with fn taking the context of each attempt and binding 4 parameters.
+func RetryContextP4R8[P1, P2, P3, P4, R1, R2, R3, R4, R5, R6, R7 any](ctx context.Context, fn func(context.Context, P1, P2, P3, P4) (R1, R2, R3, R4, R5, R6, R7, error), p1 P1, p2 P2, p3 P3, p4 P4, options ...Option) (r1 R1, r2 R2, r3 R3, r4 R4, r5 R5, r6 R6, r7 R7, err error) {
+	err = retry(
+		ctx,
+		func(ctx context.Context) (err error) {
+			r1, r2, r3, r4, r5, r6, r7, err = fn(ctx, p1, p2, p3, p4)
+			return
+		},
+		options,
+	)
+	return
+}
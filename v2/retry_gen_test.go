@@ -0,0 +1,1798 @@
+// Code generated by genretry. DO NOT EDIT.
+
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+type genCtxKey struct{}
+
+var errGen = errors.New("generated")
+
+func TestGenerated(t *testing.T) {
+	ctx := context.WithValue(context.Background(), genCtxKey{}, "value")
+	_ = ctx
+
+	t.Run("RetryR1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryR1(
+			func() error {
+				n++
+				return errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextR1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryContextR1(
+			ctx,
+			func() error {
+				n++
+				return errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryR2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, err := backoff.RetryR2(
+			func() (string, error) {
+				n++
+				return "hello", errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextR2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, err := backoff.RetryContextR2(
+			ctx,
+			func() (string, error) {
+				n++
+				return "hello", errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryR3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, err := backoff.RetryR3(
+			func() (string, int, error) {
+				n++
+				return "hello", 42, errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextR3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, err := backoff.RetryContextR3(
+			ctx,
+			func() (string, int, error) {
+				n++
+				return "hello", 42, errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryR4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, err := backoff.RetryR4(
+			func() (string, int, bool, error) {
+				n++
+				return "hello", 42, true, errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextR4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, err := backoff.RetryContextR4(
+			ctx,
+			func() (string, int, bool, error) {
+				n++
+				return "hello", 42, true, errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryR5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, err := backoff.RetryR5(
+			func() (string, int, bool, float64, error) {
+				n++
+				return "hello", 42, true, 3.14, errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextR5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, err := backoff.RetryContextR5(
+			ctx,
+			func() (string, int, bool, float64, error) {
+				n++
+				return "hello", 42, true, 3.14, errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryR6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, err := backoff.RetryR6(
+			func() (string, int, bool, float64, complex128, error) {
+				n++
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextR6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, err := backoff.RetryContextR6(
+			ctx,
+			func() (string, int, bool, float64, complex128, error) {
+				n++
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryR7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, err := backoff.RetryR7(
+			func() (string, int, bool, float64, complex128, rune, error) {
+				n++
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextR7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, err := backoff.RetryContextR7(
+			ctx,
+			func() (string, int, bool, float64, complex128, rune, error) {
+				n++
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryR8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, r7, err := backoff.RetryR8(
+			func() (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		assert.Equal(t, uint8(7), r7)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextR8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, r7, err := backoff.RetryContextR8(
+			ctx,
+			func() (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		assert.Equal(t, uint8(7), r7)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP1R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryP1R1(
+			func(a1 string) error {
+				n++
+				assert.Equal(t, "hello", a1)
+				return errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP1R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryContextP1R1(
+			ctx,
+			func(ctx context.Context, a1 string) error {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				return errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP1R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, err := backoff.RetryP1R2(
+			func(a1 string) (string, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				return "hello", errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP1R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, err := backoff.RetryContextP1R2(
+			ctx,
+			func(ctx context.Context, a1 string) (string, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				return "hello", errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP1R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, err := backoff.RetryP1R3(
+			func(a1 string) (string, int, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				return "hello", 42, errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP1R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, err := backoff.RetryContextP1R3(
+			ctx,
+			func(ctx context.Context, a1 string) (string, int, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				return "hello", 42, errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP1R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, err := backoff.RetryP1R4(
+			func(a1 string) (string, int, bool, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				return "hello", 42, true, errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP1R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, err := backoff.RetryContextP1R4(
+			ctx,
+			func(ctx context.Context, a1 string) (string, int, bool, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				return "hello", 42, true, errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP1R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, err := backoff.RetryP1R5(
+			func(a1 string) (string, int, bool, float64, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP1R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, err := backoff.RetryContextP1R5(
+			ctx,
+			func(ctx context.Context, a1 string) (string, int, bool, float64, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP1R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, err := backoff.RetryP1R6(
+			func(a1 string) (string, int, bool, float64, complex128, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP1R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, err := backoff.RetryContextP1R6(
+			ctx,
+			func(ctx context.Context, a1 string) (string, int, bool, float64, complex128, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP1R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, err := backoff.RetryP1R7(
+			func(a1 string) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP1R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, err := backoff.RetryContextP1R7(
+			ctx,
+			func(ctx context.Context, a1 string) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP1R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, r7, err := backoff.RetryP1R8(
+			func(a1 string) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		assert.Equal(t, uint8(7), r7)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP1R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, r7, err := backoff.RetryContextP1R8(
+			ctx,
+			func(ctx context.Context, a1 string) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello",
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		assert.Equal(t, uint8(7), r7)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP2R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryP2R1(
+			func(a1 string, a2 int) error {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP2R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryContextP2R1(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int) error {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP2R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, err := backoff.RetryP2R2(
+			func(a1 string, a2 int) (string, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return "hello", errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP2R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, err := backoff.RetryContextP2R2(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int) (string, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return "hello", errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP2R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, err := backoff.RetryP2R3(
+			func(a1 string, a2 int) (string, int, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return "hello", 42, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP2R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, err := backoff.RetryContextP2R3(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int) (string, int, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return "hello", 42, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP2R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, err := backoff.RetryP2R4(
+			func(a1 string, a2 int) (string, int, bool, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return "hello", 42, true, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP2R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, err := backoff.RetryContextP2R4(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int) (string, int, bool, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return "hello", 42, true, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP2R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, err := backoff.RetryP2R5(
+			func(a1 string, a2 int) (string, int, bool, float64, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP2R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, err := backoff.RetryContextP2R5(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int) (string, int, bool, float64, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP2R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, err := backoff.RetryP2R6(
+			func(a1 string, a2 int) (string, int, bool, float64, complex128, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP2R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, err := backoff.RetryContextP2R6(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int) (string, int, bool, float64, complex128, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP2R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, err := backoff.RetryP2R7(
+			func(a1 string, a2 int) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP2R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, err := backoff.RetryContextP2R7(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP2R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, r7, err := backoff.RetryP2R8(
+			func(a1 string, a2 int) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		assert.Equal(t, uint8(7), r7)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP2R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, r7, err := backoff.RetryContextP2R8(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello", 42,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		assert.Equal(t, uint8(7), r7)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP3R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryP3R1(
+			func(a1 string, a2 int, a3 bool) error {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP3R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryContextP3R1(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool) error {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP3R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, err := backoff.RetryP3R2(
+			func(a1 string, a2 int, a3 bool) (string, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return "hello", errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP3R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, err := backoff.RetryContextP3R2(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool) (string, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return "hello", errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP3R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, err := backoff.RetryP3R3(
+			func(a1 string, a2 int, a3 bool) (string, int, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return "hello", 42, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP3R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, err := backoff.RetryContextP3R3(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool) (string, int, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return "hello", 42, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP3R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, err := backoff.RetryP3R4(
+			func(a1 string, a2 int, a3 bool) (string, int, bool, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return "hello", 42, true, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP3R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, err := backoff.RetryContextP3R4(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool) (string, int, bool, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return "hello", 42, true, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP3R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, err := backoff.RetryP3R5(
+			func(a1 string, a2 int, a3 bool) (string, int, bool, float64, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP3R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, err := backoff.RetryContextP3R5(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool) (string, int, bool, float64, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP3R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, err := backoff.RetryP3R6(
+			func(a1 string, a2 int, a3 bool) (string, int, bool, float64, complex128, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP3R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, err := backoff.RetryContextP3R6(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool) (string, int, bool, float64, complex128, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP3R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, err := backoff.RetryP3R7(
+			func(a1 string, a2 int, a3 bool) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP3R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, err := backoff.RetryContextP3R7(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP3R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, r7, err := backoff.RetryP3R8(
+			func(a1 string, a2 int, a3 bool) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		assert.Equal(t, uint8(7), r7)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP3R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, r7, err := backoff.RetryContextP3R8(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello", 42, true,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		assert.Equal(t, uint8(7), r7)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP4R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryP4R1(
+			func(a1 string, a2 int, a3 bool, a4 float64) error {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP4R1", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		err := backoff.RetryContextP4R1(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool, a4 float64) error {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP4R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, err := backoff.RetryP4R2(
+			func(a1 string, a2 int, a3 bool, a4 float64) (string, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return "hello", errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP4R2", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, err := backoff.RetryContextP4R2(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool, a4 float64) (string, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return "hello", errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP4R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, err := backoff.RetryP4R3(
+			func(a1 string, a2 int, a3 bool, a4 float64) (string, int, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return "hello", 42, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP4R3", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, err := backoff.RetryContextP4R3(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool, a4 float64) (string, int, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return "hello", 42, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP4R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, err := backoff.RetryP4R4(
+			func(a1 string, a2 int, a3 bool, a4 float64) (string, int, bool, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return "hello", 42, true, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP4R4", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, err := backoff.RetryContextP4R4(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool, a4 float64) (string, int, bool, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return "hello", 42, true, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP4R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, err := backoff.RetryP4R5(
+			func(a1 string, a2 int, a3 bool, a4 float64) (string, int, bool, float64, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP4R5", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, err := backoff.RetryContextP4R5(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool, a4 float64) (string, int, bool, float64, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return "hello", 42, true, 3.14, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP4R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, err := backoff.RetryP4R6(
+			func(a1 string, a2 int, a3 bool, a4 float64) (string, int, bool, float64, complex128, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP4R6", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, err := backoff.RetryContextP4R6(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool, a4 float64) (string, int, bool, float64, complex128, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return "hello", 42, true, 3.14, 3 + 4i, errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP4R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, err := backoff.RetryP4R7(
+			func(a1 string, a2 int, a3 bool, a4 float64) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP4R7", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, err := backoff.RetryContextP4R7(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool, a4 float64) (string, int, bool, float64, complex128, rune, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryP4R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, r7, err := backoff.RetryP4R8(
+			func(a1 string, a2 int, a3 bool, a4 float64) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		assert.Equal(t, uint8(7), r7)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("RetryContextP4R8", func(t *testing.T) {
+		options := []backoff.Option{backoff.InitialInterval(1), backoff.MaxInterval(1), backoff.MaxRetries(1)}
+		n := 0
+		r1, r2, r3, r4, r5, r6, r7, err := backoff.RetryContextP4R8(
+			ctx,
+			func(ctx context.Context, a1 string, a2 int, a3 bool, a4 float64) (string, int, bool, float64, complex128, rune, uint8, error) {
+				n++
+				assert.Equal(t, "value", ctx.Value(genCtxKey{}))
+				assert.Equal(t, "hello", a1)
+				assert.Equal(t, 42, a2)
+				assert.Equal(t, true, a3)
+				assert.Equal(t, 3.14, a4)
+				return "hello", 42, true, 3.14, 3 + 4i, 'x', uint8(7), errGen
+			},
+			"hello", 42, true, 3.14,
+			options...,
+		)
+		assert.ErrorIs(t, err, errGen)
+		assert.Equal(t, "hello", r1)
+		assert.Equal(t, 42, r2)
+		assert.Equal(t, true, r3)
+		assert.Equal(t, 3.14, r4)
+		assert.Equal(t, 3+4i, r5)
+		assert.Equal(t, 'x', r6)
+		assert.Equal(t, uint8(7), r7)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 2, n)
+	})
+
+}
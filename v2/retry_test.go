@@ -393,3 +393,50 @@ func TestRetryContextR5(t *testing.T) {
 		assert.Equal(t, 1, n)
 	})
 }
+
+func TestRetryIf(t *testing.T) {
+	n := 0
+	temporary := errors.New("temporary")
+	fatal := errors.New("fatal")
+	err := backoff.Retry(
+		func() error {
+			n++
+			if n < 3 {
+				return temporary
+			}
+			return fatal
+		},
+		backoff.InitialInterval(1),
+		backoff.MaxInterval(1),
+		backoff.MaxRetries(5),
+		backoff.RetryIf(func(err error) bool { return errors.Is(err, temporary) }),
+	)
+	assert.ErrorIs(t, err, fatal)
+	// fatal はリトライしない
+	assert.Equal(t, 3, n)
+}
+
+type retryAfterError time.Duration
+
+func (e retryAfterError) Error() string { return "retry after" }
+
+func (e retryAfterError) RetryAfter() time.Duration { return time.Duration(e) }
+
+func TestRetryAfter(t *testing.T) {
+	n := 0
+	start := time.Now()
+	err := backoff.Retry(
+		func() error {
+			n++
+			if n < 2 {
+				return retryAfterError(100 * time.Millisecond)
+			}
+			return nil
+		},
+		backoff.InitialInterval(1),
+		backoff.MaxInterval(1),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
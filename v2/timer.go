@@ -0,0 +1,32 @@
+package backoff
+
+import (
+	"sync"
+	"time"
+)
+
+// timers is the pool of the timers used by the retry loops.
+var timers sync.Pool
+
+// startTimer returns a timer from the pool, which fires after d.
+func startTimer(d time.Duration) *time.Timer {
+	if t, ok := timers.Get().(*time.Timer); ok {
+		t.Reset(d)
+		return t
+	}
+	return time.NewTimer(d)
+}
+
+// stopTimer stops t, and puts it back to the pool.
+//
+// The channel is drained in case t has fired and the value is not received,
+// for the modules built with asynctimerchan=1.
+func stopTimer(t *time.Timer) {
+	if !t.Stop() {
+		select {
+		case <-t.C:
+		default:
+		}
+	}
+	timers.Put(t)
+}
@@ -0,0 +1,35 @@
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestRetryTimerReuse(t *testing.T) {
+	// プールから再利用したタイマーが古い値で即座に発火しない
+	for i := 0; i < 10; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		_ = backoff.RetryContext(ctx, func() error { return errors.New("never") }, backoff.InitialInterval(time.Millisecond), backoff.RandomizationFactor(0))
+		cancel()
+
+		n := 0
+		start := time.Now()
+		err := backoff.Retry(
+			func() error {
+				if n++; n < 2 {
+					return errors.New("temporary")
+				}
+				return nil
+			},
+			backoff.InitialInterval(5*time.Millisecond),
+			backoff.RandomizationFactor(0),
+		)
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 5*time.Millisecond)
+	}
+}
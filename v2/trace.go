@@ -0,0 +1,154 @@
+package backoff
+
+import (
+	"context"
+	"sync"
+)
+
+// Tracer creates spans, in the shape of the Tracer of OpenTelemetry.
+type Tracer interface {
+	// Start creates a span as a child of the span in ctx if any,
+	// returns the context containing the span.
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span created by [Tracer], in the shape of the Span of OpenTelemetry.
+type Span interface {
+	SetAttribute(key string, value any)
+	RecordError(err error)
+	End()
+}
+
+// Names of the spans and the attributes recorded by [WithTracer].
+const (
+	SpanNameRetry    = "backoff.Retry"
+	SpanNameAttempt  = "backoff.attempt"
+	AttributeAttempt = "backoff.attempt"
+	AttributeDelay   = "backoff.delay"
+	AttributeCount   = "backoff.attempts"
+)
+
+// WithTracer traces the retry operation by t.
+//
+// The span of the retry operation is named by [Operation], or [SpanNameRetry] if not named,
+// and has the number of attempts, and the error on giving up.
+// The span of each attempt is its child named [SpanNameAttempt],
+// and has the number of the attempt, the error, and the delay before the next attempt if retried.
+//
+// The context of the span of each attempt is passed to fn of [Poll] and [Until].
+func WithTracer(t Tracer) Option {
+	return func(bu *builder) {
+		var op, attempt Span
+		bu.onStart = append(bu.onStart, func(s *state) {
+			name := s.name
+			if name == "" {
+				name = SpanNameRetry
+			}
+			s.ctx, op = t.Start(s.ctx, name)
+		})
+		bu.onAttempt = append(bu.onAttempt, func(s *state) {
+			s.actx, attempt = t.Start(s.ctx, SpanNameAttempt)
+			attempt.SetAttribute(AttributeAttempt, s.attempts)
+		})
+		bu.onRetry = append(bu.onRetry, func(s *state) {
+			attempt.RecordError(s.err)
+			attempt.SetAttribute(AttributeDelay, s.next)
+			attempt.End()
+			attempt = nil
+		})
+		bu.onDone = append(bu.onDone, func(s *state) {
+			if attempt != nil {
+				if s.err != nil {
+					attempt.RecordError(s.err)
+				}
+				attempt.End()
+			}
+			op.SetAttribute(AttributeCount, s.attempts)
+			if s.err != nil {
+				op.RecordError(s.err)
+			}
+			op.End()
+		})
+	}
+}
+
+// NoopTracer is [Tracer] doing nothing.
+var NoopTracer Tracer = noopTracer{}
+
+type noopTracer struct{}
+
+func (noopTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(string, any) {}
+func (noopSpan) RecordError(error)        {}
+func (noopSpan) End()                     {}
+
+// TraceRecorder is [Tracer] recording spans in memory, mainly for tests.
+//
+// The zero value is ready to use.
+type TraceRecorder struct {
+	mu    sync.Mutex
+	spans []*RecordedSpan
+}
+
+var _ Tracer = (*TraceRecorder)(nil)
+
+// RecordedSpan is [Span] recorded by [TraceRecorder].
+type RecordedSpan struct {
+	mu *sync.Mutex
+
+	Name       string
+	Parent     *RecordedSpan
+	Attributes map[string]any
+	Errors     []error
+	Ended      bool
+}
+
+type recordedSpanKey struct{}
+
+// Start implements [Tracer].
+func (r *TraceRecorder) Start(ctx context.Context, name string) (context.Context, Span) {
+	parent, _ := ctx.Value(recordedSpanKey{}).(*RecordedSpan)
+	span := &RecordedSpan{
+		mu:         &r.mu,
+		Name:       name,
+		Parent:     parent,
+		Attributes: make(map[string]any),
+	}
+	r.mu.Lock()
+	r.spans = append(r.spans, span)
+	r.mu.Unlock()
+	return context.WithValue(ctx, recordedSpanKey{}, span), span
+}
+
+// Spans returns the spans recorded so far, in the order of creation.
+func (r *TraceRecorder) Spans() []*RecordedSpan {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*RecordedSpan(nil), r.spans...)
+}
+
+// SetAttribute implements [Span].
+func (s *RecordedSpan) SetAttribute(key string, value any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Attributes[key] = value
+}
+
+// RecordError implements [Span].
+func (s *RecordedSpan) RecordError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Errors = append(s.Errors, err)
+}
+
+// End implements [Span].
+func (s *RecordedSpan) End() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Ended = true
+}
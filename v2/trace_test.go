@@ -0,0 +1,83 @@
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/takumakei/go-backoff/v2"
+)
+
+func TestWithTracer(t *testing.T) {
+	t.Run("give up", func(t *testing.T) {
+		var r backoff.TraceRecorder
+		never := errors.New("never")
+		err := backoff.RetryContext(
+			context.Background(),
+			func() error { return never },
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(2),
+			backoff.WithTracer(&r),
+			backoff.Operation("connect"),
+		)
+		assert.ErrorIs(t, err, never)
+
+		spans := r.Spans()
+		require.Len(t, spans, 4)
+		op := spans[0]
+		assert.Equal(t, "connect", op.Name)
+		assert.Nil(t, op.Parent)
+		assert.Equal(t, 3, op.Attributes[backoff.AttributeCount])
+		assert.Equal(t, []error{never}, op.Errors)
+		assert.True(t, op.Ended)
+
+		for i, s := range spans[1:] {
+			assert.Equal(t, backoff.SpanNameAttempt, s.Name)
+			assert.Same(t, op, s.Parent)
+			assert.Equal(t, i+1, s.Attributes[backoff.AttributeAttempt])
+			assert.Equal(t, []error{never}, s.Errors)
+			assert.True(t, s.Ended)
+		}
+		assert.IsType(t, time.Duration(0), spans[1].Attributes[backoff.AttributeDelay])
+		assert.NotContains(t, spans[3].Attributes, backoff.AttributeDelay)
+	})
+
+	t.Run("poll", func(t *testing.T) {
+		var r backoff.TraceRecorder
+		n := 0
+		var ctxs []context.Context
+		v, err := backoff.Poll(
+			context.Background(),
+			func(ctx context.Context) (int, bool, error) {
+				n++
+				ctxs = append(ctxs, ctx)
+				return n, n == 2, nil
+			},
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.WithTracer(&r),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, v)
+
+		spans := r.Spans()
+		require.Len(t, spans, 3)
+		assert.Equal(t, backoff.SpanNameRetry, spans[0].Name)
+		assert.Empty(t, spans[0].Errors)
+		assert.Empty(t, spans[2].Errors)
+		assert.True(t, spans[2].Ended)
+
+		// fn には試行ごとのスパンを持つコンテキストが渡される
+		_, span := r.Start(ctxs[1], "child")
+		assert.Same(t, spans[2], span.(*backoff.RecordedSpan).Parent)
+	})
+
+	t.Run("noop", func(t *testing.T) {
+		err := backoff.Retry(func() error { return nil }, backoff.WithTracer(backoff.NoopTracer))
+		assert.NoError(t, err)
+	})
+}
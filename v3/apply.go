@@ -0,0 +1,29 @@
+package backoff
+
+import (
+	"github.com/cenkalti/backoff/v5"
+)
+
+// Apply applies options to exp, returns it as [BackOff].
+//
+// MaxRetries and MaxElapsedTime are not applied to exp, because they are the options of [backoff.Retry] in v5.
+// Use [RetryOptions] to apply them.
+func Apply(exp *backoff.ExponentialBackOff, options ...Option) BackOff {
+	return apply(exp, options).backOff()
+}
+
+func apply(exp *backoff.ExponentialBackOff, options []Option) *builder {
+	bu := &builder{exp: exp}
+	for _, opt := range options {
+		opt(bu)
+	}
+	return bu
+}
+
+// RetryOptions maps options onto the options of [backoff.Retry].
+//
+// RetryIf is not mapped, because it is applied by the Retry* helpers of this package.
+func RetryOptions(options ...Option) []backoff.RetryOption {
+	bu := apply(backoff.NewExponentialBackOff(), options)
+	return bu.retryOptions(bu.backOff())
+}
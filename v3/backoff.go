@@ -0,0 +1,39 @@
+package backoff
+
+import (
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+)
+
+// BackOff is the policy of the delays between the attempts.
+//
+// NextBackOff returns the delay before the next attempt, or [DefaultStop] to stop retrying.
+// Reset restarts the policy from the beginning.
+//
+// BackOff does not decouple this package from "github.com/cenkalti/backoff/v5".
+// The method set is the same as [backoff.BackOff] of it, and [FromCenkalti] and [ToCenkalti] convert without wrapping.
+// The API below still uses the types and the values of it directly.
+//
+//   - [Apply] takes [backoff.ExponentialBackOff].
+//   - [RetryOptions] and [WithRetryOptions] return and take [backoff.RetryOption].
+//   - [DefaultStop] and the other Default* constants are those of it.
+//   - The errors wrapped by [backoff.Permanent] of it are not retried.
+type BackOff interface {
+	NextBackOff() time.Duration
+	Reset()
+}
+
+// FromCenkalti returns b as [BackOff].
+//
+// b is not wrapped, so that the optional interfaces implemented by b are kept.
+func FromCenkalti(b backoff.BackOff) BackOff {
+	return b
+}
+
+// ToCenkalti returns b as [backoff.BackOff].
+//
+// b is not wrapped, so that the optional interfaces implemented by b are kept.
+func ToCenkalti(b BackOff) backoff.BackOff {
+	return b
+}
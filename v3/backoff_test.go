@@ -0,0 +1,91 @@
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v5 "github.com/cenkalti/backoff/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/takumakei/go-backoff/v3"
+)
+
+// countBackOff is a native BackOff returning 1ns, counting the calls.
+type countBackOff struct{ next, reset int }
+
+func (b *countBackOff) NextBackOff() time.Duration {
+	b.next++
+	return 1
+}
+
+func (b *countBackOff) Reset() { b.reset++ }
+
+func TestWithBackOff(t *testing.T) {
+	t.Run("native", func(t *testing.T) {
+		b := &countBackOff{}
+		n := 0
+		never := errors.New("never")
+		err := backoff.Retry(
+			func() error {
+				n++
+				return never
+			},
+			backoff.WithBackOff(b),
+			backoff.MaxRetries(3),
+		)
+		assert.ErrorIs(t, err, never)
+		assert.Equal(t, 4, n)
+		assert.Equal(t, 3, b.next)
+		assert.Equal(t, 1, b.reset)
+	})
+
+	t.Run("cenkalti", func(t *testing.T) {
+		n := 0
+		never := errors.New("never")
+		err := backoff.Retry(
+			func() error {
+				n++
+				return never
+			},
+			backoff.WithBackOff(backoff.FromCenkalti(v5.NewConstantBackOff(1))),
+			backoff.MaxRetries(3),
+		)
+		assert.ErrorIs(t, err, never)
+		assert.Equal(t, 4, n)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		n := 0
+		never := errors.New("never")
+		err := backoff.Retry(
+			func() error {
+				n++
+				return never
+			},
+			backoff.WithBackOff(&v5.StopBackOff{}),
+		)
+		assert.ErrorIs(t, err, never)
+		// fn は必ず1回実行される
+		assert.Equal(t, 1, n)
+	})
+}
+
+func TestToCenkalti(t *testing.T) {
+	b := &countBackOff{}
+	n := 0
+	_, err := v5.Retry(
+		context.Background(),
+		func() (struct{}, error) {
+			n++
+			if n < 3 {
+				return struct{}{}, errors.New("temporary")
+			}
+			return struct{}{}, nil
+		},
+		v5.WithBackOff(backoff.ToCenkalti(b)),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, 2, b.next)
+}
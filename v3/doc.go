@@ -0,0 +1,22 @@
+// Package backoff provides wrapper functions for "github.com/cenkalti/backoff/v5".
+//
+// The Retry* helpers and the options of ExponentialBackOff are the same as those of "github.com/takumakei/go-backoff/v2",
+// so that the code using only them migrates by changing the import path.
+// The rest of v2 is not provided by v3, and some of the API behaves differently.
+//
+//   - [Apply] and [New] do not apply MaxRetries and MaxElapsedTime, which are the options of Retry in v5.
+//     Use [RetryOptions] to get them.
+//   - [Apply], [FromCenkalti] and [ToCenkalti] take or return the types of v5 instead of v4.
+//   - NewContext is removed. Pass ctx to RetryContext or to Retry of v5 instead.
+//   - Stop and Clock are removed, because ExponentialBackOff of v5 has no such fields.
+//   - Introspector is removed, because ExponentialBackOff of v5 has no GetElapsedTime.
+//   - Poll, Until and Attempts are not provided.
+//   - Parse, ParsePolicy, Policy, Config, FlagVar, FromEnv, Register, RegisterConfig, Lookup, Named and UsePolicy are not provided.
+//   - Logger, WithMetrics, WithTracer, Events, EventsWithDropped and Operation are not provided.
+//   - WithBudget, RetryEach, RetryBatch and WithBackOffFunc are not provided.
+//   - The subpackages backoffhttp, backoffnet and backoffsql, and the commands are not provided.
+//
+// See README.md for the mapping of the options.
+package backoff
+
+//go:generate go -C .. run ./internal/cmd/genretry -mod v3 -dir v3
@@ -0,0 +1,19 @@
+module github.com/takumakei/go-backoff/v3
+
+go 1.23
+
+require (
+	github.com/cenkalti/backoff/v4 v4.1.3
+	github.com/cenkalti/backoff/v5 v5.0.3
+	github.com/stretchr/testify v1.8.0
+	github.com/takumakei/go-backoff/v2 v2.0.0
+	github.com/takumakei/go-bind v1.0.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/takumakei/go-backoff/v2 => ../v2
@@ -0,0 +1,209 @@
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	v4 "github.com/cenkalti/backoff/v4"
+	v5 "github.com/cenkalti/backoff/v5"
+	"github.com/stretchr/testify/assert"
+	v2 "github.com/takumakei/go-backoff/v2"
+	"github.com/takumakei/go-backoff/v3"
+)
+
+// migration is the parameters of the options given to both v2 and v3, that should have the same schedule.
+// The zero values mean the options are not given.
+type migration struct {
+	name    string
+	initial time.Duration
+	max     time.Duration
+	mult    float64
+	retries *uint64
+}
+
+func ptr[T any](v T) *T { return &v }
+
+var migrations = []migration{
+	{name: "default"},
+	{name: "InitialInterval", initial: 100 * time.Millisecond},
+	{name: "Multiplier", mult: 3},
+	{name: "MaxInterval", max: 2 * time.Second},
+	{name: "constant", initial: time.Second, max: time.Second, mult: 1},
+	{name: "MaxRetries", initial: 10 * time.Millisecond, retries: ptr[uint64](5)},
+	{name: "MaxRetries0", retries: ptr[uint64](0)},
+}
+
+// interval returns d divided by scale, or def divided by scale if d is zero and scale is not 1.
+func interval(d, def, scale time.Duration) time.Duration {
+	if d == 0 && scale != 1 {
+		d = def
+	}
+	return d / scale
+}
+
+// v2Options returns the options of v2 without jitter, with each interval divided by scale.
+func (m migration) v2Options(scale time.Duration) []v2.Option {
+	options := []v2.Option{v2.RandomizationFactor(0), v2.MaxElapsedTime(0)}
+	if d := interval(m.initial, v2.DefaultInitialInterval, scale); d != 0 {
+		options = append(options, v2.InitialInterval(d))
+	}
+	if d := interval(m.max, v2.DefaultMaxInterval, scale); d != 0 {
+		options = append(options, v2.MaxInterval(d))
+	}
+	if m.mult != 0 {
+		options = append(options, v2.Multiplier(m.mult))
+	}
+	if m.retries != nil {
+		options = append(options, v2.MaxRetries(*m.retries))
+	}
+	return options
+}
+
+// v3Options returns the options of v3 without jitter, with each interval divided by scale.
+func (m migration) v3Options(scale time.Duration) []backoff.Option {
+	options := []backoff.Option{backoff.RandomizationFactor(0), backoff.MaxElapsedTime(0)}
+	if d := interval(m.initial, backoff.DefaultInitialInterval, scale); d != 0 {
+		options = append(options, backoff.InitialInterval(d))
+	}
+	if d := interval(m.max, backoff.DefaultMaxInterval, scale); d != 0 {
+		options = append(options, backoff.MaxInterval(d))
+	}
+	if m.mult != 0 {
+		options = append(options, backoff.Multiplier(m.mult))
+	}
+	if m.retries != nil {
+		options = append(options, backoff.MaxRetries(*m.retries))
+	}
+	return options
+}
+
+// TestMigrationSchedule tests that New of v3 returns the same delays as New of v2 with the same options.
+func TestMigrationSchedule(t *testing.T) {
+	for _, m := range migrations {
+		t.Run(m.name, func(t *testing.T) {
+			b2 := v2.New(m.v2Options(1)...)
+			b2.Reset()
+			b3 := backoff.New(m.v3Options(1)...)
+			b3.Reset()
+			for i := 0; i < 30; i++ {
+				d2 := b2.NextBackOff()
+				if d2 == v2.DefaultStop {
+					// MaxRetries は v3 では New ではなく Retry で適用される
+					assert.NotNil(t, m.retries)
+					break
+				}
+				assert.Equal(t, d2, b3.NextBackOff(), "attempt %d", i+1)
+			}
+		})
+	}
+}
+
+// TestMigrationRetry tests that Retry of v3 sleeps the same delays, and calls fn the same times as Retry of v2 with the same options.
+func TestMigrationRetry(t *testing.T) {
+	// 実際に待つので間隔を 1/10000 にする
+	const scale = 10000
+	never := errors.New("never")
+
+	for _, m := range migrations {
+		t.Run(m.name, func(t *testing.T) {
+			if m.retries == nil {
+				m.retries = ptr[uint64](8)
+			}
+
+			var n2 int
+			var d2 []time.Duration
+			events := make(chan v2.Event, 64)
+			err2 := v2.Retry(
+				func() error {
+					n2++
+					return never
+				},
+				append(m.v2Options(scale), v2.Events(events))...,
+			)
+			close(events)
+			for e := range events {
+				if e.Kind == v2.EventSleeping {
+					d2 = append(d2, e.Delay)
+				}
+			}
+
+			var n3 int
+			var d3 []time.Duration
+			err3 := backoff.Retry(
+				func() error {
+					n3++
+					return never
+				},
+				append(m.v3Options(scale), backoff.WithRetryOptions(v5.WithNotify(func(_ error, d time.Duration) { d3 = append(d3, d) })))...,
+			)
+
+			assert.Equal(t, err2, err3)
+			assert.Equal(t, n2, n3)
+			assert.Equal(t, d2, d3)
+		})
+	}
+}
+
+func TestMigrationPermanent(t *testing.T) {
+	fatal := errors.New("fatal")
+
+	// v2 は cenkalti/backoff/v4 の Permanent、v3 は v5 の Permanent を使う
+	n2 := 0
+	err2 := v2.Retry(func() error {
+		n2++
+		return v4.Permanent(fatal)
+	})
+
+	n3 := 0
+	err3 := backoff.Retry(func() error {
+		n3++
+		return v5.Permanent(fatal)
+	})
+
+	assert.Equal(t, err2, err3)
+	assert.Equal(t, n2, n3)
+}
+
+func TestMigrationRetryIf(t *testing.T) {
+	fatal := errors.New("fatal")
+
+	n2 := 0
+	err2 := v2.Retry(func() error {
+		n2++
+		return fatal
+	}, v2.RetryIf(func(err error) bool { return !errors.Is(err, fatal) }))
+
+	n3 := 0
+	err3 := backoff.Retry(func() error {
+		n3++
+		return fatal
+	}, backoff.RetryIf(func(err error) bool { return !errors.Is(err, fatal) }))
+
+	assert.Equal(t, err2, err3)
+	assert.Equal(t, n2, n3)
+}
+
+func ExampleRetryOptions() {
+	// The options of this package are also usable with Retry of "github.com/cenkalti/backoff/v5".
+	n := 0
+	result, err := v5.Retry(
+		context.Background(),
+		func() (string, error) {
+			n++
+			if n < 3 {
+				return "", errors.New("temporary")
+			}
+			return "hello", nil
+		},
+		backoff.RetryOptions(
+			backoff.InitialInterval(time.Millisecond),
+			backoff.MaxRetries(5),
+		)...,
+	)
+	fmt.Println(result, err, n)
+
+	// Output: hello <nil> 3
+}
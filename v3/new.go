@@ -0,0 +1,10 @@
+package backoff
+
+import (
+	"github.com/cenkalti/backoff/v5"
+)
+
+// New creates an [backoff.ExponentialBackOff] by calling [backoff.NewExponentialBackOff], applies options to it, returns it as [BackOff].
+func New(options ...Option) BackOff {
+	return Apply(backoff.NewExponentialBackOff(), options...)
+}
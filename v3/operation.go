@@ -0,0 +1,60 @@
+package backoff
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+)
+
+// retry calls fn under [backoff.Retry] with ctx and the options mapped from options.
+func retry(ctx context.Context, fn func(context.Context) error, options []Option) error {
+	bu := apply(backoff.NewExponentialBackOff(), options)
+	d := &hintedBackOff{BackOff: bu.backOff()}
+	_, err := backoff.Retry(ctx, bu.operation(ctx, fn, d), bu.retryOptions(d)...)
+
+	// backoff.Retry returns the permanent error as it is when the attempts are exhausted.
+	var permanent *backoff.PermanentError
+	if errors.As(err, &permanent) {
+		return permanent.Unwrap()
+	}
+	return err
+}
+
+// operation wraps fn to apply RetryIf, and to pass the delay hinted by the error to d.
+func (bu *builder) operation(ctx context.Context, fn func(context.Context) error, d *hintedBackOff) backoff.Operation[struct{}] {
+	return func() (struct{}, error) {
+		err := fn(ctx)
+		if err == nil {
+			return struct{}{}, nil
+		}
+		var permanent *backoff.PermanentError
+		if errors.As(err, &permanent) {
+			return struct{}{}, err
+		}
+		if bu.retryIf != nil && !bu.retryIf(err) {
+			return struct{}{}, backoff.Permanent(err)
+		}
+		var ra interface{ RetryAfter() time.Duration }
+		if errors.As(err, &ra) {
+			d.after = ra.RetryAfter()
+		}
+		return struct{}{}, err
+	}
+}
+
+// hintedBackOff extends the next backoff to the delay hinted by the last error.
+type hintedBackOff struct {
+	backoff.BackOff
+	after time.Duration
+}
+
+func (d *hintedBackOff) NextBackOff() time.Duration {
+	next := d.BackOff.NextBackOff()
+	if next != backoff.Stop && d.after > next {
+		next = d.after
+	}
+	d.after = 0
+	return next
+}
@@ -0,0 +1,99 @@
+package backoff
+
+import (
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+)
+
+// Option is type of setting parameters of ExponentialBackOff and [backoff.Retry].
+type Option func(*builder)
+
+type builder struct {
+	exp        *backoff.ExponentialBackOff
+	custom     BackOff
+	max        *uint64
+	maxElapsed *time.Duration
+	retryIf    func(error) bool
+	opts       []backoff.RetryOption
+}
+
+// backOff returns the BackOff of the options.
+func (bu *builder) backOff() backoff.BackOff {
+	if bu.custom != nil {
+		return ToCenkalti(bu.custom)
+	}
+	return bu.exp
+}
+
+// retryOptions returns the options of [backoff.Retry] using b as BackOff.
+func (bu *builder) retryOptions(b backoff.BackOff) []backoff.RetryOption {
+	opts := []backoff.RetryOption{backoff.WithBackOff(b)}
+	if bu.max != nil {
+		// MaxRetries counts the retries, WithMaxTries counts the attempts.
+		// math.MaxUint64 overflows to 0, that is unlimited as well as MaxRetries.
+		opts = append(opts, backoff.WithMaxTries(uint(*bu.max+1)))
+	}
+	if bu.maxElapsed != nil {
+		opts = append(opts, backoff.WithMaxElapsedTime(*bu.maxElapsed))
+	}
+	return append(opts, bu.opts...)
+}
+
+// InitialInterval uses d as InitialInterval.
+//
+// see: https://pkg.go.dev/github.com/cenkalti/backoff/v5#ExponentialBackOff
+func InitialInterval(d time.Duration) Option {
+	return func(bu *builder) { bu.exp.InitialInterval = d }
+}
+
+// RandomizationFactor uses f as RandomizationFactor.
+//
+// see: https://pkg.go.dev/github.com/cenkalti/backoff/v5#ExponentialBackOff
+func RandomizationFactor(f float64) Option {
+	return func(bu *builder) { bu.exp.RandomizationFactor = f }
+}
+
+// Multiplier uses f as Multiplier.
+//
+// see: https://pkg.go.dev/github.com/cenkalti/backoff/v5#ExponentialBackOff
+func Multiplier(f float64) Option {
+	return func(bu *builder) { bu.exp.Multiplier = f }
+}
+
+// MaxInterval uses d as MaxInterval.
+//
+// see: https://pkg.go.dev/github.com/cenkalti/backoff/v5#ExponentialBackOff
+func MaxInterval(d time.Duration) Option {
+	return func(bu *builder) { bu.exp.MaxInterval = d }
+}
+
+// WithBackOff uses b instead of ExponentialBackOff.
+//
+// The options of ExponentialBackOff such as [InitialInterval] are ignored,
+// while [MaxRetries] and [MaxElapsedTime] are applied.
+// b is either an implementation of this package or of "github.com/cenkalti/backoff/v5" through [FromCenkalti].
+func WithBackOff(b BackOff) Option {
+	return func(bu *builder) { bu.custom = b }
+}
+
+// MaxElapsedTime applies [backoff.WithMaxElapsedTime] with d.
+func MaxElapsedTime(d time.Duration) Option {
+	return func(bu *builder) { bu.maxElapsed = &d }
+}
+
+// MaxRetries applies [backoff.WithMaxTries] with max+1, so that fn is retried at most max times.
+func MaxRetries(max uint64) Option {
+	return func(bu *builder) { bu.max = &max }
+}
+
+// RetryIf retries only errors for which pred returns true.
+// The other errors are returned immediately as if they were wrapped by [backoff.Permanent].
+func RetryIf(pred func(error) bool) Option {
+	return func(bu *builder) { bu.retryIf = pred }
+}
+
+// WithRetryOptions applies opts to [backoff.Retry] after the options mapped from the other options.
+func WithRetryOptions(opts ...backoff.RetryOption) Option {
+	return func(bu *builder) { bu.opts = append(bu.opts, opts...) }
+}
@@ -0,0 +1,22 @@
+package backoff
+
+import (
+	"context"
+)
+
+// Retry the function fn until it does not return error or BackOff stops.
+//
+// BackOff is created by [New] with options.
+//
+// If the error returned by fn has the method RetryAfter() time.Duration,
+// the next delay is extended to the duration returned by it.
+func Retry(fn func() error, options ...Option) error {
+	return retry(context.Background(), func(context.Context) error { return fn() }, options)
+}
+
+// RetryContext the function fn until it does not return error or BackOff stops.
+//
+// BackOff is created by [New] with options, and ctx is passed to Retry of "github.com/cenkalti/backoff/v5".
+func RetryContext(ctx context.Context, fn func() error, options ...Option) error {
+	return retry(ctx, func(context.Context) error { return fn() }, options)
+}
@@ -0,0 +1,158 @@
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/takumakei/go-backoff/v3"
+	"github.com/takumakei/go-bind"
+)
+
+func Example() {
+	// mockAPI takes 2 parameters, returns 2 values.
+	mockAPI := func(ctx context.Context, name string) (string, error) { return name, nil }
+
+	ctx := context.Background()
+
+	result, err := backoff.RetryContextR2(
+		ctx,
+		bind.P2R2(mockAPI, ctx, "hello"),
+		backoff.MaxInterval(7*time.Second),
+		backoff.MaxElapsedTime(7*time.Second),
+		backoff.MaxRetries(7),
+	)
+	if err != nil {
+		fmt.Printf("error: %v", err)
+	} else {
+		fmt.Println(result)
+	}
+
+	// Output: hello
+}
+
+func TestRetry(t *testing.T) {
+	n := 0
+	never := errors.New("never")
+	err := backoff.Retry(
+		func() error {
+			n++
+			return never
+		},
+		backoff.InitialInterval(1),
+		backoff.MaxInterval(1),
+		backoff.MaxRetries(3),
+	)
+	assert.ErrorIs(t, err, never)
+	// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+	assert.Equal(t, 4, n)
+}
+
+func TestRetryContext(t *testing.T) {
+	t.Run("max", func(t *testing.T) {
+		n := 0
+		never := errors.New("never")
+		err := backoff.RetryContext(
+			context.Background(),
+			func() error {
+				n++
+				return never
+			},
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(3),
+		)
+		assert.ErrorIs(t, err, never)
+		// fn は必ず1回実行され、err != nil ならば最大 MaxRetries 回リトライ実行する.
+		assert.Equal(t, 4, n)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel() // キャンセル
+
+		n := 0
+		never := errors.New("never")
+		err := backoff.RetryContext(
+			ctx,
+			func() error {
+				n++
+				return never
+			},
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(3),
+		)
+		assert.ErrorIs(t, err, context.Canceled)
+		// fn は必ず1回実行される
+		assert.Equal(t, 1, n)
+	})
+}
+
+func TestRetryIf(t *testing.T) {
+	temporary := errors.New("temporary")
+	fatal := errors.New("fatal")
+
+	t.Run("fatal", func(t *testing.T) {
+		n := 0
+		err := backoff.Retry(
+			func() error {
+				n++
+				if n < 3 {
+					return temporary
+				}
+				return fatal
+			},
+			backoff.InitialInterval(1),
+			backoff.MaxInterval(1),
+			backoff.MaxRetries(5),
+			backoff.RetryIf(func(err error) bool { return errors.Is(err, temporary) }),
+		)
+		assert.Equal(t, fatal, err)
+		// fatal はリトライしない
+		assert.Equal(t, 3, n)
+	})
+
+	t.Run("last", func(t *testing.T) {
+		n := 0
+		err := backoff.Retry(
+			func() error {
+				n++
+				return fatal
+			},
+			backoff.MaxRetries(0),
+			backoff.RetryIf(func(err error) bool { return false }),
+		)
+		// 最後の試行でも PermanentError に包まれずに返る
+		assert.Equal(t, fatal, err)
+		assert.Equal(t, 1, n)
+	})
+}
+
+type retryAfterError time.Duration
+
+func (e retryAfterError) Error() string { return "retry after" }
+
+func (e retryAfterError) RetryAfter() time.Duration { return time.Duration(e) }
+
+func TestRetryAfter(t *testing.T) {
+	n := 0
+	start := time.Now()
+	err := backoff.Retry(
+		func() error {
+			n++
+			if n < 2 {
+				return retryAfterError(100 * time.Millisecond)
+			}
+			return nil
+		},
+		backoff.InitialInterval(1),
+		backoff.MaxInterval(1),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}